@@ -0,0 +1,71 @@
+package cloudant
+
+// Container for a generated Cloudant API key
+// http://docs.cloudant.com/api/authorization.html#generating-api-keys
+type APIKey struct {
+	Key      string `json:"key"`
+	Password string `json:"password"`
+	Ok       bool   `json:"ok"`
+}
+
+// Generate a new API key.  The key has no permissions until it is granted some on a database.
+func (client *Client) GenerateAPIKey() (*APIKey, error) {
+	key := &APIKey{}
+
+	resp, err := client.doRequest("POST", "/_api/v2/api_keys", nil, nil)
+	err = client.handleResponse(resp, err, 201, key)
+
+	return key, err
+}
+
+// Create a client for the same account and transport that authenticates with the given API key.
+func (client *Client) WithAPIKey(key *APIKey) *Client {
+	return &Client{
+		httpClient:     client.httpClient,
+		longHttpClient: client.longHttpClient,
+		rootUri:        client.rootUri,
+		apiKey:         key.Key,
+		apiPassword:    key.Password,
+		PrintResponses: client.PrintResponses,
+	}
+}
+
+// Grant permissions on the database to an API key, preserving the permissions of other keys.
+func (db *Database) GrantAPIKey(key string, permissions ...string) error {
+	var sec *Security
+	var err error
+
+	if sec, err = db.GetSecurity(); err != nil {
+		return err
+	}
+
+	sec.Grant(key, permissions...)
+
+	return db.SetSecurity(sec)
+}
+
+// Remove all permissions on the database from an API key.
+func (db *Database) RevokeAPIKey(key string) error {
+	var sec *Security
+	var err error
+
+	if sec, err = db.GetSecurity(); err != nil {
+		return err
+	}
+
+	sec.Revoke(key)
+
+	return db.SetSecurity(sec)
+}
+
+// Generate a new API key and grant it permissions on the database.
+func (db *Database) GenerateAPIKey(permissions ...string) (*APIKey, error) {
+	var key *APIKey
+	var err error
+
+	if key, err = db.client.GenerateAPIKey(); err != nil {
+		return key, err
+	}
+
+	return key, db.GrantAPIKey(key.Key, permissions...)
+}
@@ -0,0 +1,61 @@
+package cloudant_test
+
+import (
+	. "github.com/obieq/go-cloudant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("APIKey", func() {
+	It("should generate an api key", func() {
+		key, err := testClient.GenerateAPIKey()
+		Ω(err).NotTo(HaveOccurred())
+		Ω(key.Ok).Should(BeTrue())
+		Ω(key.Key).ShouldNot(BeEmpty())
+		Ω(key.Password).ShouldNot(BeEmpty())
+	})
+
+	It("should generate an api key with permissions on a database", func() {
+		key, err := testDb.GenerateAPIKey(PermissionReader)
+		Ω(err).NotTo(HaveOccurred())
+
+		sec, err := testDb.GetSecurity()
+		Ω(err).NotTo(HaveOccurred())
+		Ω(sec.Cloudant[key.Key]).Should(Equal([]string{PermissionReader}))
+
+		// the new key can read but not write
+		db, err := NewDatabase(testDbName, testClient.WithAPIKey(key))
+		Ω(err).NotTo(HaveOccurred())
+		_, err = db.GetIndices()
+		Ω(err).NotTo(HaveOccurred())
+		_, err = db.CreateDocument(&CloudantAutomobile{Year: 1970, Make: "Plymouth", Model: "Superbird"}, false)
+		Ω(err).To(HaveOccurred())
+
+		err = testDb.RevokeAPIKey(key.Key)
+		Ω(err).NotTo(HaveOccurred())
+
+		sec, err = testDb.GetSecurity()
+		Ω(err).NotTo(HaveOccurred())
+		Ω(sec.Cloudant).ShouldNot(HaveKey(key.Key))
+	})
+
+	Describe("Error Handling", func() {
+		It("should return an unauthorized error for invalid credentials", func() {
+			_, err := errClientResponse.GenerateAPIKey()
+			Ω(err).To(HaveOccurred())
+			Ω(err.(*CloudantError).StatusCode).Should(Equal(401))
+		})
+
+		It("should return an error if the http request fails", func() {
+			db := errClientRequest.GetDatabase("non-existent-db-name")
+			_, err := db.GenerateAPIKey(PermissionReader)
+			Ω(err).To(HaveOccurred())
+
+			err = db.GrantAPIKey("key", PermissionReader)
+			Ω(err).To(HaveOccurred())
+
+			err = db.RevokeAPIKey("key")
+			Ω(err).To(HaveOccurred())
+		})
+	})
+})
@@ -2,11 +2,14 @@ package cloudant
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/mreiferson/go-httpclient"
@@ -14,6 +17,7 @@ import (
 
 type Client struct {
 	httpClient     *http.Client
+	longHttpClient *http.Client // no request timeouts, for requests bounded by a context
 	rootUri        string
 	apiKey         string
 	apiPassword    string
@@ -42,6 +46,22 @@ func (e *CloudantError) Error() string {
 	return fmt.Sprintf("%s (%d): %s %s", e.Status, e.StatusCode, e.Code, e.Detail)
 }
 
+// An implementation of 'error' returned when a value is rejected before any request is made.
+type ValidationError struct {
+	// What was validated, e.g. "database name"
+	Field string
+
+	// The rejected value.
+	Value string
+
+	// Why the value was rejected.
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s %q: %s", e.Field, e.Value, e.Reason)
+}
+
 var (
 	DefaultTransport *httpclient.Transport = &httpclient.Transport{
 		ConnectTimeout:        1 * time.Second,
@@ -59,6 +79,7 @@ func NewClient(rootUri string, apiKey string, apiPassword string) *Client {
 func NewClientWithTransport(rootUri string, apiKey string, apiPassword string, transport *httpclient.Transport) *Client {
 	return &Client{
 		httpClient:     &http.Client{Transport: transport},
+		longHttpClient: &http.Client{Transport: withoutRequestTimeouts(transport)},
 		rootUri:        rootUri,
 		apiKey:         apiKey,
 		apiPassword:    apiPassword,
@@ -66,6 +87,19 @@ func NewClientWithTransport(rootUri string, apiKey string, apiPassword string, t
 	}
 }
 
+// Copy of a transport without the request, response header and read/write timeouts,
+// for requests such as _replicate that only respond once the work is done.
+func withoutRequestTimeouts(transport *httpclient.Transport) *httpclient.Transport {
+	return &httpclient.Transport{
+		Proxy:               transport.Proxy,
+		ConnectTimeout:      transport.ConnectTimeout,
+		TLSClientConfig:     transport.TLSClientConfig,
+		DisableKeepAlives:   transport.DisableKeepAlives,
+		DisableCompression:  transport.DisableCompression,
+		MaxIdleConnsPerHost: transport.MaxIdleConnsPerHost,
+	}
+}
+
 // Decodes Cloudant responses.
 func (c *Client) decode(r io.Reader, receiver interface{}) error {
 	var decoder *json.Decoder
@@ -84,10 +118,35 @@ func (c *Client) decode(r io.Reader, receiver interface{}) error {
 
 // Executes an HTTP request.
 func (c *Client) doRequest(method, trailing string, headers map[string]string, body io.Reader) (*http.Response, error) {
+	return c.doRequestWithContext(context.Background(), method, trailing, headers, body)
+}
+
+// Executes an HTTP request that is aborted when the context is cancelled.
+func (c *Client) doRequestWithContext(ctx context.Context, method, trailing string, headers map[string]string, body io.Reader) (*http.Response, error) {
+	req, err := c.newRequest(ctx, method, trailing, headers, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.httpClient.Do(req)
+}
+
+// Executes an HTTP request without the transport's request timeouts, so the context is the only deadline.
+func (c *Client) doLongRequestWithContext(ctx context.Context, method, trailing string, headers map[string]string, body io.Reader) (*http.Response, error) {
+	req, err := c.newRequest(ctx, method, trailing, headers, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.longHttpClient.Do(req)
+}
+
+func (c *Client) newRequest(ctx context.Context, method, trailing string, headers map[string]string, body io.Reader) (*http.Request, error) {
 	req, err := http.NewRequest(method, c.rootUri+trailing, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
 
 	req.SetBasicAuth(c.apiKey, c.apiPassword)
 
@@ -95,12 +154,15 @@ func (c *Client) doRequest(method, trailing string, headers map[string]string, b
 		req.Header.Add(k, v)
 	}
 
-	req.Header.Add("Accept", "application/json")
-	if method == "POST" || method == "PUT" || method == "PATCH" {
+	// default to JSON unless the caller provided its own headers
+	if req.Header.Get("Accept") == "" {
+		req.Header.Add("Accept", "application/json")
+	}
+	if req.Header.Get("Content-Type") == "" && (method == "POST" || method == "PUT" || method == "PATCH") {
 		req.Header.Add("Content-Type", "application/json")
 	}
 
-	return c.httpClient.Do(req)
+	return req, nil
 }
 
 func (client *Client) handleResponse(resp *http.Response, err error, successStatusCode int, result interface{}) error {
@@ -143,3 +205,19 @@ func NotFoundError() *CloudantError {
 		Detail:     "missing",
 	}
 }
+
+// Escapes a single URL path segment, including characters such as '/', '+' and '$'.
+func escapePathSegment(segment string) string {
+	return strings.Replace(url.QueryEscape(segment), "+", "%20", -1)
+}
+
+// Escapes a document id.  The '/' of design and local document prefixes is preserved.
+func escapeDocId(id string) string {
+	for _, prefix := range []string{"_design/", "_local/"} {
+		if strings.HasPrefix(id, prefix) {
+			return prefix + escapePathSegment(strings.TrimPrefix(id, prefix))
+		}
+	}
+
+	return escapePathSegment(id)
+}
@@ -68,6 +68,19 @@ var _ = Describe("Client", func() {
 		Ω(err).NotTo(HaveOccurred())
 	})
 
+	Describe("Escaping", func() {
+		It("should escape path segments", func() {
+			Ω(escapePathSegment("cars/2015+$")).Should(Equal("cars%2F2015%2B%24"))
+			Ω(escapePathSegment("a b#c?d")).Should(Equal("a%20b%23c%3Fd"))
+		})
+
+		It("should escape document ids", func() {
+			Ω(escapeDocId("a b#c?d")).Should(Equal("a%20b%23c%3Fd"))
+			Ω(escapeDocId("_design/a/b")).Should(Equal("_design/a%2Fb"))
+			Ω(escapeDocId("_local/a b")).Should(Equal("_local/a%20b"))
+		})
+	})
+
 	Describe("Error Handling", func() {
 		Context("doRequest", func() {
 			It("should return an error if http.NewRequest fails", func() {
@@ -111,6 +124,13 @@ var _ = Describe("Client", func() {
 			})
 		})
 
+		Context("ValidationError", func() {
+			It("should have an Error() method", func() {
+				ve := &ValidationError{Field: "database name", Value: "Cars", Reason: "must begin with a lowercase letter"}
+				Ω(ve.Error()).Should(Equal(`invalid database name "Cars": must begin with a lowercase letter`))
+			})
+		})
+
 		Context("CloudantError", func() {
 			It("should have an Error() method", func() {
 				Ω(cError.Error()).Should(Equal("400 Bad Request (400): bad_request Invalid rev format"))
@@ -82,7 +82,8 @@ var _ = BeforeSuite(func() {
 	Expect(err).NotTo(HaveOccurred())
 
 	// get an instance of the new database
-	testDb = NewDatabase(testDbName, testClient)
+	testDb, err = NewDatabase(testDbName, testClient)
+	Expect(err).NotTo(HaveOccurred())
 	Ω(testDb).ShouldNot(BeNil())
 	Ω(testDb.Client()).ShouldNot(BeNil())
 	Ω(testDb.Name()).Should(Equal(testDbName))
@@ -0,0 +1,115 @@
+package cloudant
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Merges the winning revision of a document with its conflicting revisions.
+// The returned document is written as the new winning revision; its _id and _rev are set automatically.
+type ConflictResolver func(winner json.RawMessage, losers []json.RawMessage) (interface{}, error)
+
+// Get the conflicting (losing) revisions of a document.  The revisions are decoded
+// into conflicts, which must be a pointer to a slice.  Returns the winning revision.
+func (db *Database) GetConflicts(id string, conflicts interface{}) (string, error) {
+	var winner json.RawMessage
+
+	meta, err := db.GetDocumentWithOptions(id, GetDocumentOptions{Conflicts: true}, &winner)
+	if err != nil {
+		return meta.Revision, err
+	}
+
+	if len(meta.Conflicts) == 0 {
+		return meta.Revision, json.Unmarshal([]byte("[]"), conflicts)
+	}
+
+	_, err = db.GetOpenRevisions(id, meta.Conflicts, false, conflicts)
+	return meta.Revision, err
+}
+
+// Resolve the conflicts of a document.  The merged document returned by the resolver is written
+// on top of the winning revision first; the losing revisions are only deleted if that write succeeds,
+// so merged content is never lost if the winner changed in the meantime.  Nothing is written if the
+// document has no conflicts.  A BulkDocumentError is returned if any write is rejected.
+func (db *Database) ResolveConflicts(id string, resolver ConflictResolver) ([]BulkDocumentResponse, error) {
+	var winner json.RawMessage
+	var losers []json.RawMessage
+	var merged interface{}
+
+	meta, err := db.GetDocumentWithOptions(id, GetDocumentOptions{Conflicts: true}, &winner)
+	if err != nil || len(meta.Conflicts) == 0 {
+		return []BulkDocumentResponse{}, err
+	}
+
+	if _, err = db.GetOpenRevisions(id, meta.Conflicts, false, &losers); err != nil {
+		return []BulkDocumentResponse{}, err
+	}
+
+	if merged, err = resolver(winner, losers); err != nil {
+		return []BulkDocumentResponse{}, err
+	}
+
+	j, err := json.Marshal(merged)
+	if err != nil {
+		return []BulkDocumentResponse{}, err
+	}
+
+	doc := make(map[string]interface{})
+	if err = json.Unmarshal(j, &doc); err != nil {
+		return []BulkDocumentResponse{}, err
+	}
+	doc["_id"] = id
+	doc["_rev"] = meta.Revision
+
+	// _bulk_docs isn't atomic and returns 201 even if writes are rejected, so check each result
+	bdr, err := db.BulkDocs([]interface{}{doc}, true)
+	if err == nil {
+		err = bulkDocsErr(bdr)
+	}
+	if err != nil {
+		return bdr, err
+	}
+
+	deletions := []interface{}{}
+	for _, rev := range meta.Conflicts {
+		deletions = append(deletions, map[string]interface{}{"_id": id, "_rev": rev, "_deleted": true})
+	}
+
+	deleted, err := db.BulkDocs(deletions, true)
+	bdr = append(bdr, deleted...)
+	if err == nil {
+		err = bulkDocsErr(deleted)
+	}
+
+	return bdr, err
+}
+
+// Returns the error of the first rejected document, if any.
+func bulkDocsErr(bdr []BulkDocumentResponse) error {
+	for _, r := range bdr {
+		if err := r.Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Find all documents with conflicts via a query on _conflicts.
+func (db *Database) FindConflictedDocuments(ctx context.Context) ([]DocumentMeta, error) {
+	conflicted := []DocumentMeta{}
+
+	q := NewQuery()
+	q.Selector = Field("_conflicts").Exists(true)
+	q.Fields = []string{"_id", "_rev", "_conflicts"}
+	q.Conflicts = true
+
+	iter := db.QueryIter(ctx, q)
+	meta := DocumentMeta{}
+	for iter.Next(&meta) {
+		conflicted = append(conflicted, meta)
+		meta = DocumentMeta{}
+	}
+
+	return conflicted, iter.Err()
+}
@@ -0,0 +1,126 @@
+package cloudant_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	. "github.com/obieq/go-cloudant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Conflict", func() {
+	var conflictedId string
+
+	// Create a document with two conflicting revisions, as replication between regions would
+	BeforeEach(func() {
+		conflictedId = GenerateRandomUUID()
+		docs := []interface{}{
+			map[string]interface{}{"_id": conflictedId, "_rev": "1-aaaa", "Year": 1967, "Make": "Ford", "Model": "Mustang"},
+			map[string]interface{}{"_id": conflictedId, "_rev": "1-bbbb", "Year": 1967, "Make": "Ford", "Model": "Shelby GT500"},
+		}
+
+		_, err := testDb.BulkDocs(docs, false)
+		Ω(err).NotTo(HaveOccurred())
+	})
+
+	It("should get conflicting revisions", func() {
+		conflicts := []CloudantAutomobile{}
+		winner, err := testDb.GetConflicts(conflictedId, &conflicts)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(winner).Should(Equal("1-bbbb"))
+		Ω(len(conflicts)).Should(Equal(1))
+		Ω(conflicts[0].Revision()).Should(Equal("1-aaaa"))
+		Ω(conflicts[0].Model).Should(Equal("Mustang"))
+	})
+
+	It("should find conflicted documents", func() {
+		conflicted, err := testDb.FindConflictedDocuments(context.Background())
+		Ω(err).NotTo(HaveOccurred())
+
+		ids := []string{}
+		for _, meta := range conflicted {
+			ids = append(ids, meta.Id)
+		}
+		Ω(ids).Should(ContainElement(conflictedId))
+	})
+
+	It("should resolve conflicts", func() {
+		bdr, err := testDb.ResolveConflicts(conflictedId, func(winner json.RawMessage, losers []json.RawMessage) (interface{}, error) {
+			merged := CloudantAutomobile{}
+			Ω(json.Unmarshal(winner, &merged)).NotTo(HaveOccurred())
+			Ω(len(losers)).Should(Equal(1))
+
+			merged.Trim = "merged"
+			return merged, nil
+		})
+		Ω(err).NotTo(HaveOccurred())
+		Ω(len(bdr)).Should(Equal(2))
+		Ω(bdr[0].Ok).Should(BeTrue())
+		Ω(bdr[1].Ok).Should(BeTrue())
+
+		conflicts := []CloudantAutomobile{}
+		_, err = testDb.GetConflicts(conflictedId, &conflicts)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(len(conflicts)).Should(Equal(0))
+
+		auto := CloudantAutomobile{}
+		err = testDb.GetDocument(conflictedId, &auto)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(auto.Trim).Should(Equal("merged"))
+
+		// nothing to resolve anymore
+		bdr, err = testDb.ResolveConflicts(conflictedId, nil)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(len(bdr)).Should(Equal(0))
+	})
+
+	Describe("Error Handling", func() {
+		It("should return the error of the resolver", func() {
+			_, err := testDb.ResolveConflicts(conflictedId, func(winner json.RawMessage, losers []json.RawMessage) (interface{}, error) {
+				return nil, errors.New("can not merge")
+			})
+			Ω(err).To(HaveOccurred())
+			Ω(err.Error()).Should(Equal("can not merge"))
+		})
+
+		It("should return an error if the merged document fails json.Marshal", func() {
+			_, err := testDb.ResolveConflicts(conflictedId, func(winner json.RawMessage, losers []json.RawMessage) (interface{}, error) {
+				return GenerateInvalidJson(), nil
+			})
+			Ω(err).To(HaveOccurred())
+			Ω(err.Error()).Should(Equal("json: unsupported type: map[int]interface {}"))
+		})
+
+		It("should not delete the losing revisions if the winner changed before the merge was written", func() {
+			_, err := testDb.ResolveConflicts(conflictedId, func(winner json.RawMessage, losers []json.RawMessage) (interface{}, error) {
+				merged := CloudantAutomobile{}
+				Ω(json.Unmarshal(winner, &merged)).NotTo(HaveOccurred())
+
+				// a concurrent writer updates the winning revision
+				concurrent := merged
+				concurrent.Trim = "concurrent"
+				_, err := testDb.UpdateDocument(&concurrent, false)
+				Ω(err).NotTo(HaveOccurred())
+
+				merged.Trim = "merged"
+				return merged, nil
+			})
+			Ω(err).To(HaveOccurred())
+			Ω(err.(*BulkDocumentError).Code).Should(Equal("conflict"))
+
+			conflicts := []CloudantAutomobile{}
+			_, err = testDb.GetConflicts(conflictedId, &conflicts)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(len(conflicts)).Should(Equal(1))
+			Ω(conflicts[0].Model).Should(Equal("Mustang"))
+		})
+
+		It("should return a 404 error for a non-existent document", func() {
+			_, err := testDb.GetConflicts("does_not_exist", &[]CloudantAutomobile{})
+			Ω(err).To(HaveOccurred())
+			Ω(err.(*CloudantError).StatusCode).Should(Equal(404))
+		})
+	})
+})
@@ -0,0 +1,69 @@
+package cloudant
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+)
+
+// Container for the account's CORS configuration
+// http://docs.cloudant.com/api/cors.html
+type CORSConfig struct {
+	EnableCORS       bool     `json:"enable_cors"`
+	AllowCredentials bool     `json:"allow_credentials"`
+	Origins          []string `json:"origins"`
+}
+
+func (client *Client) GetCORSConfig() (*CORSConfig, error) {
+	cc := &CORSConfig{}
+
+	resp, err := client.doRequest("GET", "/_api/v2/user/config/cors", nil, nil)
+	err = client.handleResponse(resp, err, 200, cc)
+
+	return cc, err
+}
+
+// Replace the account's CORS configuration.  Origins are validated before sending.
+func (client *Client) SetCORSConfig(cc *CORSConfig) error {
+	ok := okResponse{}
+
+	if err := cc.Validate(); err != nil {
+		return err
+	}
+
+	j, err := json.Marshal(cc)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.doRequest("PUT", "/_api/v2/user/config/cors", nil, bytes.NewReader(j))
+	return client.handleResponse(resp, err, 200, &ok)
+}
+
+// Verify that each origin is either "*" or an http(s) scheme and host without a path, query or fragment.
+func (cc *CORSConfig) Validate() error {
+	for _, origin := range cc.Origins {
+		if origin == "*" {
+			continue
+		}
+
+		u, err := url.Parse(origin)
+		if err != nil {
+			return &ValidationError{Field: "CORS origin", Value: origin, Reason: err.Error()}
+		}
+
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return &ValidationError{Field: "CORS origin", Value: origin, Reason: "scheme must be http or https"}
+		}
+
+		if u.Host == "" {
+			return &ValidationError{Field: "CORS origin", Value: origin, Reason: "missing host"}
+		}
+
+		if u.Path != "" || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+			return &ValidationError{Field: "CORS origin", Value: origin, Reason: "must only contain a scheme, host and port"}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package cloudant_test
+
+import (
+	. "github.com/obieq/go-cloudant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CORS", func() {
+	var original *CORSConfig
+
+	BeforeEach(func() {
+		var err error
+		original, err = testClient.GetCORSConfig()
+		Ω(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Ω(testClient.SetCORSConfig(original)).NotTo(HaveOccurred())
+	})
+
+	It("should set and get the cors config", func() {
+		cc := &CORSConfig{EnableCORS: true, AllowCredentials: true, Origins: []string{"https://example.com", "http://localhost:8080"}}
+		err := testClient.SetCORSConfig(cc)
+		Ω(err).NotTo(HaveOccurred())
+
+		cc, err = testClient.GetCORSConfig()
+		Ω(err).NotTo(HaveOccurred())
+		Ω(cc.EnableCORS).Should(BeTrue())
+		Ω(cc.AllowCredentials).Should(BeTrue())
+		Ω(cc.Origins).Should(ConsistOf("https://example.com", "http://localhost:8080"))
+	})
+
+	Describe("Validating", func() {
+		It("should accept valid origins", func() {
+			cc := &CORSConfig{Origins: []string{"*", "https://example.com", "http://localhost:8080"}}
+			Ω(cc.Validate()).NotTo(HaveOccurred())
+		})
+
+		It("should reject invalid origins", func() {
+			for _, origin := range []string{"example.com", "ftp://example.com", "https://", "https://example.com/", "https://example.com/path", "https://example.com?q=1", "%gh"} {
+				cc := &CORSConfig{Origins: []string{origin}}
+				Ω(cc.Validate()).To(HaveOccurred())
+				Ω(testClient.SetCORSConfig(cc)).To(HaveOccurred())
+			}
+		})
+	})
+
+	Describe("Error Handling", func() {
+		It("should return an error if the http request fails", func() {
+			_, err := errClientRequest.GetCORSConfig()
+			Ω(err).To(HaveOccurred())
+
+			err = errClientRequest.SetCORSConfig(&CORSConfig{})
+			Ω(err).To(HaveOccurred())
+		})
+	})
+})
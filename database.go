@@ -1,5 +1,13 @@
 package cloudant
 
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
 type Database struct {
 	name   string
 	client *Client
@@ -13,8 +21,23 @@ func (db *Database) Client() *Client {
 	return db.client
 }
 
-func NewDatabase(name string, client *Client) *Database {
-	return &Database{name: name, client: client}
+// Create a database handle.  The name is validated against CouchDB's naming rules.
+func NewDatabase(name string, client *Client) (*Database, error) {
+	if err := ValidateDatabaseName(name); err != nil {
+		return nil, err
+	}
+
+	return &Database{name: name, client: client}, nil
+}
+
+// The escaped URL path of the database
+func (db *Database) path() string {
+	return "/" + escapePathSegment(db.name)
+}
+
+// The escaped URL path of a document in the database
+func (db *Database) docPath(id string) string {
+	return db.path() + "/" + escapeDocId(id)
 }
 
 func (client *Client) GetDatabase(name string) Database {
@@ -30,9 +53,129 @@ func (client *Client) ListDatabases() ([]string, error) {
 	return dbs, err
 }
 
+var systemDatabaseNames = []string{"_users", "_replicator", "_global_changes"}
+
+// Verify a database name against CouchDB's naming rules
+// http://docs.couchdb.org/en/stable/api/database/common.html#put--db
+func ValidateDatabaseName(name string) error {
+	for _, sys := range systemDatabaseNames {
+		if name == sys {
+			return nil
+		}
+	}
+
+	if name == "" {
+		return &ValidationError{Field: "database name", Value: name, Reason: "must not be empty"}
+	}
+
+	if len(name) > 238 {
+		return &ValidationError{Field: "database name", Value: name, Reason: "must not be longer than 238 characters"}
+	}
+
+	if name[0] < 'a' || name[0] > 'z' {
+		return &ValidationError{Field: "database name", Value: name, Reason: "must begin with a lowercase letter"}
+	}
+
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z') && !(c >= '0' && c <= '9') && !strings.ContainsRune("_$()+-/", c) {
+			return &ValidationError{Field: "database name", Value: name,
+				Reason: "must only contain lowercase letters, digits and the characters _$()+-/"}
+		}
+	}
+
+	return nil
+}
+
+// Options used when creating a database
+type DatabaseOptions struct {
+	Q           int // number of shards, defaults to the cluster setting
+	N           int // number of replicas, defaults to the cluster setting
+	Partitioned bool
+}
+
+// Container for database information
+// http://docs.cloudant.com/api/database.html#getting-database-details
+type DatabaseInfo struct {
+	DbName         string                 `json:"db_name"`
+	DocCount       int                    `json:"doc_count"`
+	DocDelCount    int                    `json:"doc_del_count"`
+	UpdateSeq      interface{}            `json:"update_seq"`
+	PurgeSeq       interface{}            `json:"purge_seq"`
+	CompactRunning bool                   `json:"compact_running"`
+	Sizes          DatabaseSizes          `json:"sizes"`
+	Cluster        DatabaseCluster        `json:"cluster"`
+	Props          map[string]interface{} `json:"props"`
+}
+
+type DatabaseSizes struct {
+	File     int64 `json:"file"`
+	External int64 `json:"external"`
+	Active   int64 `json:"active"`
+}
+
+type DatabaseCluster struct {
+	Q int `json:"q"`
+	N int `json:"n"`
+	W int `json:"w"`
+	R int `json:"r"`
+}
+
+// Container for the results of _all_docs, views and search indexes.
+// Rows holds the receiver passed to the request.
+type ViewResults struct {
+	TotalRows int         `json:"total_rows"`
+	Offset    int         `json:"offset"`
+	Bookmark  string      `json:"bookmark"`
+	Rows      interface{} `json:"rows"`
+}
+
+// Information about one of the databases requested via Client.DatabasesInfo.
+// Error is set instead of Info if the database doesn't exist.
+type DatabasesInfoEntry struct {
+	Key   string        `json:"key"`
+	Info  *DatabaseInfo `json:"info"`
+	Error string        `json:"error"`
+}
+
+type databasesInfoRequest struct {
+	Keys []string `json:"keys"`
+}
+
+func (opts DatabaseOptions) values() url.Values {
+	v := url.Values{}
+
+	if opts.Q > 0 {
+		v.Set("q", strconv.Itoa(opts.Q))
+	}
+
+	if opts.N > 0 {
+		v.Set("n", strconv.Itoa(opts.N))
+	}
+
+	if opts.Partitioned {
+		v.Set("partitioned", "true")
+	}
+
+	return v
+}
+
 func (client *Client) CreateDatabase(name string) (CloudantDocumentResponse, error) {
+	return client.CreateDatabaseWithOptions(name, DatabaseOptions{})
+}
+
+func (client *Client) CreateDatabaseWithOptions(name string, opts DatabaseOptions) (CloudantDocumentResponse, error) {
 	cdr := CloudantDocumentResponse{}
-	resp, err := client.doRequest("PUT", "/"+name, nil, nil)
+	uri := "/" + escapePathSegment(name)
+
+	if err := ValidateDatabaseName(name); err != nil {
+		return cdr, err
+	}
+
+	if v := opts.values(); len(v) > 0 {
+		uri += "?" + v.Encode()
+	}
+
+	resp, err := client.doRequest("PUT", uri, nil, nil)
 	err = client.handleResponse(resp, err, 201, &cdr)
 
 	return cdr, err
@@ -40,8 +183,56 @@ func (client *Client) CreateDatabase(name string) (CloudantDocumentResponse, err
 
 func (client *Client) DeleteDatabase(name string) (CloudantDocumentResponse, error) {
 	cdr := CloudantDocumentResponse{}
-	resp, err := client.doRequest("DELETE", "/"+name, nil, nil)
+	resp, err := client.doRequest("DELETE", "/"+escapePathSegment(name), nil, nil)
 	err = client.handleResponse(resp, err, 200, &cdr)
 
 	return cdr, err
 }
+
+func (db *Database) Info() (*DatabaseInfo, error) {
+	info := &DatabaseInfo{}
+
+	resp, err := db.client.doRequest("GET", db.path(), nil, nil)
+	err = db.client.handleResponse(resp, err, 200, info)
+
+	return info, err
+}
+
+// True if the database was created with DatabaseOptions.Partitioned
+func (info *DatabaseInfo) Partitioned() bool {
+	partitioned, _ := info.Props["partitioned"].(bool)
+	return partitioned
+}
+
+// Get information about multiple databases in a single request.
+func (client *Client) DatabasesInfo(names []string) ([]DatabasesInfoEntry, error) {
+	entries := []DatabasesInfoEntry{}
+
+	j, err := json.Marshal(databasesInfoRequest{Keys: names})
+	if err != nil {
+		return entries, err
+	}
+
+	resp, err := client.doRequest("POST", "/_dbs_info", nil, bytes.NewReader(j))
+	err = client.handleResponse(resp, err, 200, &entries)
+
+	return entries, err
+}
+
+func (client *Client) getRows(uri string, opts map[string]string, rows interface{}) (ViewResults, error) {
+	vr := ViewResults{Rows: rows}
+
+	v := url.Values{}
+	for k, val := range opts {
+		v.Set(k, val)
+	}
+
+	if len(v) > 0 {
+		uri += "?" + v.Encode()
+	}
+
+	resp, err := client.doRequest("GET", uri, nil, nil)
+	err = client.handleResponse(resp, err, 200, &vr)
+
+	return vr, err
+}
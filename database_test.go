@@ -1,6 +1,9 @@
 package cloudant_test
 
 import (
+	"strings"
+
+	. "github.com/obieq/go-cloudant"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -30,9 +33,108 @@ var _ = Describe("Database", func() {
 		Ω(dbs).Should(ContainElement(dbName))
 	})
 
+	It("should get database info", func() {
+		db := testClient.GetDatabase(dbName)
+		info, err := db.Info()
+		Ω(err).NotTo(HaveOccurred())
+		Ω(info.DbName).Should(Equal(dbName))
+		Ω(info.DocCount).Should(Equal(0))
+		Ω(info.UpdateSeq).ShouldNot(BeNil())
+		Ω(info.Sizes.File).Should(BeNumerically(">", 0))
+		Ω(info.Partitioned()).Should(BeFalse())
+	})
+
+	It("should get info for multiple databases", func() {
+		entries, err := testClient.DatabasesInfo([]string{dbName, "does_not_exist"})
+		Ω(err).NotTo(HaveOccurred())
+		Ω(len(entries)).Should(Equal(2))
+		Ω(entries[0].Key).Should(Equal(dbName))
+		Ω(entries[0].Info).ShouldNot(BeNil())
+		Ω(entries[0].Info.DbName).Should(Equal(dbName))
+		Ω(entries[1].Info).Should(BeNil())
+		Ω(entries[1].Error).Should(Equal("not_found"))
+	})
+
 	It("should delete a database", func() {
 		cdr, err := testClient.DeleteDatabase(dbName)
 		Ω(err).NotTo(HaveOccurred())
 		Ω(cdr).ShouldNot(BeNil())
 	})
+
+	It("should create a database with options", func() {
+		name := dbName + "_with_options"
+		testClient.DeleteDatabase(name)
+
+		_, err := testClient.CreateDatabaseWithOptions(name, DatabaseOptions{Q: 2, N: 3, Partitioned: true})
+		Ω(err).NotTo(HaveOccurred())
+
+		db := testClient.GetDatabase(name)
+		info, err := db.Info()
+		Ω(err).NotTo(HaveOccurred())
+		Ω(info.Cluster.Q).Should(Equal(2))
+		Ω(info.Cluster.N).Should(Equal(3))
+		Ω(info.Partitioned()).Should(BeTrue())
+
+		_, err = testClient.DeleteDatabase(name)
+		Ω(err).NotTo(HaveOccurred())
+	})
+
+	It("should create and delete a database with special characters", func() {
+		name := dbName + "_a/b+c$(d)-e"
+		testClient.DeleteDatabase(name)
+
+		_, err := testClient.CreateDatabase(name)
+		Ω(err).NotTo(HaveOccurred())
+
+		db, err := NewDatabase(name, testClient)
+		Ω(err).NotTo(HaveOccurred())
+		info, err := db.Info()
+		Ω(err).NotTo(HaveOccurred())
+		Ω(info.DbName).Should(Equal(name))
+
+		_, err = testClient.DeleteDatabase(name)
+		Ω(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Validating", func() {
+		It("should accept valid database names", func() {
+			for _, name := range []string{"cars", "cars_2015", "a/b+c$(d)-e", "_users", "_replicator", "_global_changes"} {
+				Ω(ValidateDatabaseName(name)).NotTo(HaveOccurred())
+			}
+		})
+
+		It("should reject invalid database names", func() {
+			for _, name := range []string{"", "Cars", "2015_cars", "_cars", "cars!", "cars and trucks", strings.Repeat("a", 239)} {
+				err := ValidateDatabaseName(name)
+				Ω(err).To(HaveOccurred())
+				Ω(err).Should(BeAssignableToTypeOf(&ValidationError{}))
+			}
+		})
+
+		It("should not create a database handle with an invalid name", func() {
+			db, err := NewDatabase("Cars", testClient)
+			Ω(err).To(HaveOccurred())
+			Ω(db).Should(BeNil())
+		})
+
+		It("should not create a database with an invalid name", func() {
+			_, err := testClient.CreateDatabase("Cars")
+			Ω(err).To(HaveOccurred())
+			Ω(err.(*ValidationError).Field).Should(Equal("database name"))
+		})
+	})
+
+	Describe("Error Handling", func() {
+		It("should return a 404 error for a non-existent database", func() {
+			db := testClient.GetDatabase("does_not_exist")
+			_, err := db.Info()
+			Ω(err).To(HaveOccurred())
+			Ω(err.(*CloudantError).StatusCode).Should(Equal(404))
+		})
+
+		It("should return an error if the http request fails", func() {
+			_, err := errClientRequest.DatabasesInfo([]string{dbName})
+			Ω(err).To(HaveOccurred())
+		})
+	})
 })
@@ -1,11 +1,20 @@
 package cloudant
 
+import (
+	"bytes"
+	"encoding/json"
+)
+
 // Container for cloudant design document information
 // http://docs.cloudant.com/api/design-documents-get-put-delete-copy.html
 type DesignDocument struct {
 	CloudantDocument
-	Language string                        `json:"language"`
-	Views    map[string]designDocumentView `json:"views"`
+	Language  string                        `json:"language,omitempty"`
+	Views     map[string]designDocumentView `json:"views,omitempty"`
+	StIndexes map[string]GeoIndex           `json:"st_indexes,omitempty"`
+	Updates   map[string]string             `json:"updates,omitempty"`
+	Shows     map[string]string             `json:"shows,omitempty"`
+	Lists     map[string]string             `json:"lists,omitempty"`
 }
 
 type designDocumentView struct {
@@ -29,12 +38,32 @@ type designDocumentViewOptionDefinition struct {
 
 func (db *Database) GetDesignDocument(id string) (*DesignDocument, error) {
 	doc := &DesignDocument{}
-	resp, err := db.client.doRequest("GET", "/"+db.Name()+"/_design/"+id, nil, nil)
+	resp, err := db.client.doRequest("GET", db.docPath("_design/"+id), nil, nil)
 	err = db.client.handleResponse(resp, err, 200, doc)
 
 	return doc, err
 }
 
+// Create or update a design document.  The id must include the "_design/" prefix and
+// the revision must be set when updating an existing design document.
+func (db *Database) SaveDesignDocument(ddoc *DesignDocument) (CloudantDocumentResponse, error) {
+	cdr := CloudantDocumentResponse{}
+
+	j, err := json.Marshal(ddoc)
+	if err != nil {
+		return cdr, err
+	}
+
+	resp, err := db.client.doRequest("PUT", db.docPath(ddoc.Id()), nil, bytes.NewReader(j))
+	err = db.client.handleResponse(resp, err, 201, &cdr)
+	return cdr, err
+}
+
+// Copy a design document.  The names must not include the "_design/" prefix.
+func (db *Database) CopyDesignDocument(srcName string, dstName string, dstRevision string) (CloudantDocumentResponse, error) {
+	return db.CopyDocument("_design/"+srcName, "", "_design/"+dstName, dstRevision)
+}
+
 func (ddoc *DesignDocument) ViewKeys() []string {
 	keys := make([]string, 0, len(ddoc.Views))
 	for k := range ddoc.Views {
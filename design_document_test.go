@@ -1,10 +1,27 @@
 package cloudant_test
 
 import (
+	. "github.com/obieq/go-cloudant"
 	. "github.com/onsi/ginkgo"
-	//. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("DesignDocument", func() {
+	It("should save and copy a design document", func() {
+		name := "ddoc_" + GenerateRandomUUID()
+		ddoc := &DesignDocument{Language: "javascript"}
+		ddoc.SetId("_design/" + name)
 
+		cdr, err := testDb.SaveDesignDocument(ddoc)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(cdr.Id).Should(Equal("_design/" + name))
+
+		cdr, err = testDb.CopyDesignDocument(name, name+"_copy", "")
+		Ω(err).NotTo(HaveOccurred())
+		Ω(cdr.Id).Should(Equal("_design/" + name + "_copy"))
+
+		copied, err := testDb.GetDesignDocument(name + "_copy")
+		Ω(err).NotTo(HaveOccurred())
+		Ω(copied.Language).Should(Equal("javascript"))
+	})
 })
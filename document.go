@@ -3,6 +3,11 @@ package cloudant
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
 )
 
 type CloudantDocumentInterfacer interface {
@@ -20,6 +25,33 @@ type CloudantDocument struct {
 	cloudantDocument
 }
 
+// Result of writing a single document via Database.BulkDocs.
+// Error and Reason are set if the document could not be written.
+type BulkDocumentResponse struct {
+	Id       string `json:"id"`
+	Revision string `json:"rev"`
+	Ok       bool   `json:"ok"`
+	Error    string `json:"error"`
+	Reason   string `json:"reason"`
+}
+
+// An implementation of 'error' returned when a document of a bulk write was rejected, e.g. with a conflict.
+type BulkDocumentError struct {
+	Id       string
+	Revision string
+	Code     string
+	Reason   string
+}
+
+func (e *BulkDocumentError) Error() string {
+	return fmt.Sprintf("bulk write of %s failed: %s %s", e.Id, e.Code, e.Reason)
+}
+
+type bulkDocsRequest struct {
+	Docs     []interface{} `json:"docs"`
+	NewEdits *bool         `json:"new_edits,omitempty"`
+}
+
 type CloudantDocumentResponse struct {
 	Id       string `json:"id"`
 	Revision string `json:"rev"`
@@ -39,14 +71,84 @@ func (doc *CloudantDocument) Revision() string {
 	return doc.DocRevision
 }
 
+// Returns a BulkDocumentError if the document could not be written, otherwise nil.
+func (r BulkDocumentResponse) Err() error {
+	if r.Error == "" {
+		return nil
+	}
+
+	return &BulkDocumentError{Id: r.Id, Revision: r.Revision, Code: r.Error, Reason: r.Reason}
+}
+
 func (db *Database) GetDocument(id string, doc interface{}) error {
-	resp, err := db.client.doRequest("GET", "/"+db.Name()+"/"+id, nil, nil)
+	resp, err := db.client.doRequest("GET", db.docPath(id), nil, nil)
 	return db.client.handleResponse(resp, err, 200, doc)
 }
 
+// Check whether a document exists without downloading its body.
+func (db *Database) DocumentExists(id string) (bool, error) {
+	if _, err := db.headDocument(id); err != nil {
+		if ce, ok := err.(*CloudantError); ok && ce.StatusCode == 404 {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Get the current revision of a document without downloading its body.
+func (db *Database) GetRevision(id string) (string, error) {
+	resp, err := db.headDocument(id)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Trim(resp.Header.Get("ETag"), `"`), nil
+}
+
+// Get a document unless its current revision matches the given revision, in which case
+// the document is not downloaded and false is returned.  False is also returned with any error.
+func (db *Database) GetDocumentIfNoneMatch(id string, revision string, doc interface{}) (bool, error) {
+	headers := map[string]string{"If-None-Match": `"` + revision + `"`}
+	resp, err := db.client.doRequest("GET", db.docPath(id), headers, nil)
+
+	if err == nil && resp.StatusCode == 304 {
+		resp.Body.Close()
+		return false, nil
+	}
+
+	if err = db.client.handleResponse(resp, err, 200, doc); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (db *Database) headDocument(id string) (*http.Response, error) {
+	resp, err := db.client.doRequest("HEAD", db.docPath(id), nil, nil)
+	if err != nil {
+		return resp, err
+	}
+	resp.Body.Close()
+
+	// HEAD responses have no body, so the error details can't be read
+	if resp.StatusCode != 200 {
+		return resp, &CloudantError{Status: resp.Status, StatusCode: resp.StatusCode}
+	}
+
+	return resp, nil
+}
+
+// Create a document.  Documents with an id are created via an idempotent PUT (see PutDocument),
+// otherwise the id is generated by cloudant.
 func (db *Database) CreateDocument(doc interface{}, isBatch bool) (CloudantDocumentResponse, error) {
+	if d, ok := doc.(CloudantDocumentInterfacer); ok && d.Id() != "" {
+		return db.putDocument(d.Id(), doc, isBatch)
+	}
+
 	cdr := CloudantDocumentResponse{}
-	uri := "/" + db.Name()
+	uri := db.path()
 	successStatusCode := 201
 
 	j, err := json.Marshal(doc)
@@ -64,9 +166,69 @@ func (db *Database) CreateDocument(doc interface{}, isBatch bool) (CloudantDocum
 	return cdr, err
 }
 
+// Create a document with the given id.  If the document already exists with the same content,
+// e.g. because a timed out request is retried, the resulting 409 conflict is treated as success
+// and the stored revision is returned.
+func (db *Database) PutDocument(id string, doc interface{}) (CloudantDocumentResponse, error) {
+	return db.putDocument(id, doc, false)
+}
+
+func (db *Database) putDocument(id string, doc interface{}, isBatch bool) (CloudantDocumentResponse, error) {
+	cdr := CloudantDocumentResponse{}
+	uri := db.docPath(id)
+	successStatusCode := 201
+
+	j, err := json.Marshal(doc)
+	if err != nil {
+		return cdr, err
+	}
+
+	if isBatch {
+		uri += "?batch=ok"
+		successStatusCode = 202
+	}
+
+	resp, err := db.client.doRequest("PUT", uri, nil, bytes.NewReader(j))
+	err = db.client.handleResponse(resp, err, successStatusCode, &cdr)
+
+	if ce, ok := err.(*CloudantError); ok && ce.StatusCode == 409 {
+		if rev, isSame := db.matchesStoredDocument(id, j); isSame {
+			return CloudantDocumentResponse{Id: id, Revision: rev}, nil
+		}
+	}
+
+	return cdr, err
+}
+
+// Compare a JSON encoded document with the stored document, ignoring special fields such as _id and _rev.
+// Returns the stored revision.
+func (db *Database) matchesStoredDocument(id string, j []byte) (string, bool) {
+	doc := make(map[string]interface{})
+	stored := make(map[string]interface{})
+
+	if err := json.Unmarshal(j, &doc); err != nil {
+		return "", false
+	}
+
+	if err := db.GetDocument(id, &stored); err != nil {
+		return "", false
+	}
+
+	rev, _ := stored["_rev"].(string)
+	for _, m := range []map[string]interface{}{doc, stored} {
+		for k := range m {
+			if strings.HasPrefix(k, "_") {
+				delete(m, k)
+			}
+		}
+	}
+
+	return rev, reflect.DeepEqual(doc, stored)
+}
+
 func (db *Database) UpdateDocument(doc CloudantDocumentInterfacer, isBatch bool) (CloudantDocumentResponse, error) {
 	cdr := CloudantDocumentResponse{}
-	uri := "/" + db.Name() + "/" + doc.Id() + "?rev=" + doc.Revision()
+	uri := db.docPath(doc.Id()) + "?rev=" + url.QueryEscape(doc.Revision())
 	successStatusCode := 201
 
 	j, err := json.Marshal(doc)
@@ -87,7 +249,53 @@ func (db *Database) UpdateDocument(doc CloudantDocumentInterfacer, isBatch bool)
 func (db *Database) DeleteDocument(id string, revision string) (CloudantDocumentResponse, error) {
 	cdr := CloudantDocumentResponse{}
 
-	resp, err := db.client.doRequest("DELETE", "/"+db.Name()+"/"+id+"?rev="+revision, nil, nil)
+	resp, err := db.client.doRequest("DELETE", db.docPath(id)+"?rev="+url.QueryEscape(revision), nil, nil)
 	err = db.client.handleResponse(resp, err, 200, &cdr)
 	return cdr, err
 }
+
+// Create, update or delete multiple documents in a single request.
+// When isNewEdits is false, documents are stored with the revisions they specify, as the replicator does.
+// http://docs.cloudant.com/api/documents.html#bulk-operations
+func (db *Database) BulkDocs(docs []interface{}, isNewEdits bool) ([]BulkDocumentResponse, error) {
+	bdr := []BulkDocumentResponse{}
+	req := bulkDocsRequest{Docs: docs}
+
+	if !isNewEdits {
+		req.NewEdits = &isNewEdits
+	}
+
+	j, err := json.Marshal(req)
+	if err != nil {
+		return bdr, err
+	}
+
+	resp, err := db.client.doRequest("POST", db.path()+"/_bulk_docs", nil, bytes.NewReader(j))
+	err = db.client.handleResponse(resp, err, 201, &bdr)
+	return bdr, err
+}
+
+// Copy a document on the server.  The source revision is optional and defaults to the winning revision.
+// The destination revision must be set to overwrite an existing destination document.
+// Design documents can be copied by using ids with the _design/ prefix.
+// http://docs.cloudant.com/api/documents.html#copy
+func (db *Database) CopyDocument(srcId string, srcRevision string, dstId string, dstRevision string) (CloudantDocumentResponse, error) {
+	cdr := CloudantDocumentResponse{}
+	uri := db.docPath(srcId)
+
+	// the Destination header is read as is, without percent-decoding, so the id must not be escaped
+	destination := dstId
+
+	if srcRevision != "" {
+		uri += "?rev=" + url.QueryEscape(srcRevision)
+	}
+
+	if dstRevision != "" {
+		destination += "?rev=" + url.QueryEscape(dstRevision)
+	}
+
+	headers := map[string]string{"Destination": destination}
+	resp, err := db.client.doRequest("COPY", uri, headers, nil)
+	err = db.client.handleResponse(resp, err, 201, &cdr)
+	return cdr, err
+}
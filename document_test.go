@@ -1,6 +1,7 @@
 package cloudant_test
 
 import (
+	. "github.com/obieq/go-cloudant"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -27,6 +28,40 @@ var _ = Describe("Document", func() {
 			CreateDocumentAndAssert(&doc, docId, true)
 		})
 
+		It("should create a document with a generated id", func() {
+			auto := CloudantAutomobile{Year: autoYear, Make: autoMake, Model: autoModel}
+			cdr, err := testDb.CreateDocument(&auto, false)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(cdr.Id).ShouldNot(BeEmpty())
+		})
+
+		It("should put a document idempotently", func() {
+			cdr, err := testDb.PutDocument(docId, &doc)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(cdr.Id).Should(Equal(docId))
+
+			// a retry with the same content succeeds
+			retried, err := testDb.PutDocument(docId, &doc)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(retried.Id).Should(Equal(docId))
+			Ω(retried.Revision).Should(Equal(cdr.Revision))
+
+			// a retry via CreateDocument succeeds as well
+			retried, err = testDb.CreateDocument(&doc, false)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(retried.Revision).Should(Equal(cdr.Revision))
+		})
+
+		It("should return a 409 error when putting different content", func() {
+			_, err := testDb.PutDocument(docId, &doc)
+			Ω(err).NotTo(HaveOccurred())
+
+			doc.Year = autoYear + 1
+			_, err = testDb.PutDocument(docId, &doc)
+			Ω(err).To(HaveOccurred())
+			Ω(err.(*CloudantError).StatusCode).Should(Equal(409))
+		})
+
 		//It("should create 600 documents in batch mode", func() {
 		//for i := 1; i <= 600; i++ {
 		//auto := CloudantAutomobile{Year: autoYear, Make: autoMake, Model: autoModel}
@@ -52,6 +87,72 @@ var _ = Describe("Document", func() {
 		})
 	})
 
+	Context("Escaping", func() {
+		It("should create, get, update and delete a document with special characters in its id", func() {
+			docId = "a b#c?d/" + GenerateRandomUUID()
+			doc.SetId(docId)
+			CreateDocumentAndAssert(&doc, docId, false)
+
+			err := testDb.GetDocument(docId, &doc)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(doc.Id()).Should(Equal(docId))
+
+			cdr, err := testDb.UpdateDocument(&doc, false)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(cdr.Id).Should(Equal(docId))
+
+			cdr, err = testDb.DeleteDocument(docId, cdr.Revision)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(cdr.Id).Should(Equal(docId))
+		})
+	})
+
+	Context("Checking", func() {
+		It("should check whether a document exists", func() {
+			exists, err := testDb.DocumentExists(docId)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(exists).Should(BeFalse())
+
+			CreateDocumentAndAssert(&doc, docId, false)
+
+			exists, err = testDb.DocumentExists(docId)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(exists).Should(BeTrue())
+		})
+
+		It("should get the current revision", func() {
+			cdr, err := testDb.CreateDocument(&doc, false)
+			Ω(err).NotTo(HaveOccurred())
+
+			rev, err := testDb.GetRevision(docId)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(rev).Should(Equal(cdr.Revision))
+
+			_, err = testDb.GetRevision("does_not_exist")
+			Ω(err).To(HaveOccurred())
+			Ω(err.(*CloudantError).StatusCode).Should(Equal(404))
+		})
+
+		It("should only get a document if it was modified", func() {
+			cdr, err := testDb.CreateDocument(&doc, false)
+			Ω(err).NotTo(HaveOccurred())
+
+			isModified, err := testDb.GetDocumentIfNoneMatch(docId, cdr.Revision, &doc)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(isModified).Should(BeFalse())
+
+			isModified, err = testDb.GetDocumentIfNoneMatch(docId, "1-outdated", &doc)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(isModified).Should(BeTrue())
+			Ω(doc.Revision()).Should(Equal(cdr.Revision))
+
+			isModified, err = testDb.GetDocumentIfNoneMatch("does_not_exist", "1-outdated", &doc)
+			Ω(err).To(HaveOccurred())
+			Ω(err.(*CloudantError).StatusCode).Should(Equal(404))
+			Ω(isModified).Should(BeFalse())
+		})
+	})
+
 	Context("Updating", func() {
 		It("should update a document", func() {
 			CreateDocumentAndAssert(&doc, docId, true)
@@ -116,6 +217,52 @@ var _ = Describe("Document", func() {
 		})
 	})
 
+	Context("Copying", func() {
+		It("should copy a document", func() {
+			cdr, err := testDb.CreateDocument(&doc, false)
+			Ω(err).NotTo(HaveOccurred())
+
+			copyId := "copy of " + docId
+			copied, err := testDb.CopyDocument(docId, cdr.Revision, copyId, "")
+			Ω(err).NotTo(HaveOccurred())
+			Ω(copied.Id).Should(Equal(copyId))
+			Ω(copied.Revision).ShouldNot(BeEmpty())
+
+			auto := CloudantAutomobile{}
+			err = testDb.GetDocument(copyId, &auto)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(auto.Model).Should(Equal(autoModel))
+
+			// overwrite the copy
+			overwritten, err := testDb.CopyDocument(docId, "", copyId, copied.Revision)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(overwritten.Revision).ShouldNot(Equal(copied.Revision))
+		})
+
+		It("should return a 409 error when overwriting without the destination revision", func() {
+			CreateDocumentAndAssert(&doc, docId, false)
+
+			_, err := testDb.CopyDocument(docId, "", "copy of "+docId, "")
+			Ω(err).NotTo(HaveOccurred())
+
+			_, err = testDb.CopyDocument(docId, "", "copy of "+docId, "")
+			Ω(err).To(HaveOccurred())
+			Ω(err.(*CloudantError).StatusCode).Should(Equal(409))
+		})
+	})
+
+	Context("Bulk", func() {
+		It("should create multiple documents in one request", func() {
+			other := CloudantAutomobile{Year: autoYear, Make: autoMake, Model: autoModel}
+			bdr, err := testDb.BulkDocs([]interface{}{&doc, &other}, true)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(len(bdr)).Should(Equal(2))
+			Ω(bdr[0].Id).Should(Equal(docId))
+			Ω(bdr[0].Ok).Should(BeTrue())
+			Ω(bdr[1].Revision).ShouldNot(BeEmpty())
+		})
+	})
+
 	Describe("Error Handling", func() {
 		Context("Creating", func() {
 			It("should return an error if the document fails json.Marshal", func() {
@@ -123,6 +270,21 @@ var _ = Describe("Document", func() {
 				Ω(err).To(HaveOccurred())
 				Ω(err.Error()).Should(Equal("json: unsupported type: map[int]interface {}"))
 			})
+
+			It("should return an error if the document with an id fails json.Marshal", func() {
+				doc.FluxCapacitor = GenerateInvalidJson()
+				_, err := testDb.PutDocument(docId, &doc)
+				Ω(err).To(HaveOccurred())
+				Ω(err.Error()).Should(Equal("json: unsupported type: map[int]interface {}"))
+			})
+		})
+
+		Context("Bulk", func() {
+			It("should return an error if a document fails json.Marshal", func() {
+				_, err := testDb.BulkDocs([]interface{}{GenerateInvalidJson()}, true)
+				Ω(err).To(HaveOccurred())
+				Ω(err.Error()).Should(Equal("json: unsupported type: map[int]interface {}"))
+			})
 		})
 
 		Context("Updating", func() {
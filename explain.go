@@ -0,0 +1,118 @@
+package cloudant
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Container for the plan cloudant uses to execute a query
+// http://docs.cloudant.com/api/cloudant-query.html#explain-plans
+type QueryPlan struct {
+	DbName          string                 `json:"dbname"`
+	Index           Index                  `json:"index"`
+	Partitioned     interface{}            `json:"partitioned"`
+	Selector        map[string]interface{} `json:"selector"`
+	Options         map[string]interface{} `json:"opts"`
+	Limit           int                    `json:"limit"`
+	Skip            int                    `json:"skip"`
+	Fields          interface{}            `json:"fields"` // "all_fields" or a list of field names
+	MRArgs          QueryPlanMRArgs        `json:"mrargs"`
+	Covering        bool                   `json:"covering"`
+	IndexCandidates []IndexCandidate       `json:"index_candidates"`
+}
+
+// Map/reduce arguments used to read the chosen index, including the key range
+type QueryPlanMRArgs struct {
+	IncludeDocs bool            `json:"include_docs"`
+	ViewType    string          `json:"view_type"`
+	Reduce      bool            `json:"reduce"`
+	Partition   interface{}     `json:"partition"`
+	StartKey    json.RawMessage `json:"start_key"`
+	EndKey      json.RawMessage `json:"end_key"`
+	Direction   string          `json:"direction"`
+	Stable      bool            `json:"stable"`
+	Update      interface{}     `json:"update"`
+	Conflicts   interface{}     `json:"conflicts"`
+}
+
+// An index that was considered for the query, along with the reasons it was or wasn't chosen
+type IndexCandidate struct {
+	Index    Index         `json:"index"`
+	Analysis IndexAnalysis `json:"analysis"`
+}
+
+type IndexAnalysis struct {
+	Usable   bool          `json:"usable"`
+	Reasons  []IndexReason `json:"reasons"`
+	Ranking  int           `json:"ranking"`
+	Covering bool          `json:"covering"`
+}
+
+type IndexReason struct {
+	Name string `json:"name"`
+}
+
+// Get the plan cloudant would use to execute the query, without executing it.
+// http://docs.cloudant.com/api/cloudant-query.html#explain-plans
+func (db *Database) Explain(q *Query) (*QueryPlan, error) {
+	plan := &QueryPlan{}
+
+	j, err := json.Marshal(q)
+	if err != nil {
+		return plan, err
+	}
+
+	resp, err := db.client.doRequest("POST", db.path()+"/_explain", nil, bytes.NewReader(j))
+	err = db.client.handleResponse(resp, err, 200, plan)
+
+	return plan, err
+}
+
+// True if no usable index was found and the query scans all documents via _all_docs.
+func (plan *QueryPlan) IsFullScan() bool {
+	return plan.Index.Name == "_all_docs" || plan.Index.Type == "special"
+}
+
+// Returns a warning describing the full scan if the query doesn't use an index, otherwise an empty string.
+func (plan *QueryPlan) FullScanWarning() string {
+	if !plan.IsFullScan() {
+		return ""
+	}
+
+	found := make(map[string]bool)
+	selectorFields(plan.Selector, found)
+
+	fields := make([]string, 0, len(found))
+	for f := range found {
+		fields = append(fields, f)
+	}
+	sort.Strings(fields)
+
+	return fmt.Sprintf("query on database %s falls back to an _all_docs full scan; no usable index for fields [%s]",
+		plan.DbName, strings.Join(fields, ", "))
+}
+
+// Collect the field names of a selector.  Explain returns the normalized selector, so the fields of a
+// multi-field query are nested in combination operators such as {"$and": [...]}.
+func selectorFields(s interface{}, found map[string]bool) {
+	switch v := s.(type) {
+	case []interface{}:
+		for _, sub := range v {
+			selectorFields(sub, found)
+		}
+	case map[string]interface{}:
+		for k, sub := range v {
+			switch k {
+			case "$and", "$or", "$nor", "$not":
+				selectorFields(sub, found)
+			default:
+				if !strings.HasPrefix(k, "$") {
+					found[k] = true
+				}
+			}
+		}
+	}
+}
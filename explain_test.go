@@ -0,0 +1,66 @@
+package cloudant_test
+
+import (
+	. "github.com/obieq/go-cloudant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Explain", func() {
+	var query *Query
+
+	BeforeEach(func() {
+		// create test query data (will only be performed once per test run)
+		createTestQueryDataWithIndices()
+
+		query = NewQuery()
+	})
+
+	It("should explain a query using an index", func() {
+		query.Selector = Field("Model").Eq("Gallardo")
+		plan, err := testDb.Explain(query)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(plan.DbName).Should(Equal(testDbName))
+		Ω(plan.Index.Type).Should(Equal("json"))
+		Ω(plan.Index.Definition.Fields[0]).Should(HaveKey("Model"))
+		Ω(plan.Limit).Should(Equal(25))
+		Ω(plan.MRArgs.StartKey).ShouldNot(BeEmpty())
+		Ω(plan.IsFullScan()).Should(BeFalse())
+		Ω(plan.FullScanWarning()).Should(BeEmpty())
+	})
+
+	It("should warn about a full scan", func() {
+		plan := &QueryPlan{DbName: testDbName, Selector: map[string]interface{}{"Trim": "sport", "Color": "red"}}
+		plan.Index.Name = "_all_docs"
+		plan.Index.Type = "special"
+
+		Ω(plan.IsFullScan()).Should(BeTrue())
+		Ω(plan.FullScanWarning()).Should(Equal("query on database " + testDbName +
+			" falls back to an _all_docs full scan; no usable index for fields [Color, Trim]"))
+	})
+
+	It("should warn about a full scan of a multi-field query without an index", func() {
+		query.Selector = MatchAny(Field("Trim").Eq("sport"), Field("FluxCapacitor").Exists(true))
+		plan, err := testDb.Explain(query)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(plan.IsFullScan()).Should(BeTrue())
+		Ω(plan.FullScanWarning()).Should(Equal("query on database " + testDbName +
+			" falls back to an _all_docs full scan; no usable index for fields [FluxCapacitor, Trim]"))
+	})
+
+	Describe("Error Handling", func() {
+		It("should return an error if selector fails json.Marshal", func() {
+			query.Selector = GenerateInvalidJson()
+			_, err := testDb.Explain(query)
+			Ω(err).To(HaveOccurred())
+			Ω(err.Error()).Should(Equal("json: unsupported type: map[int]interface {}"))
+		})
+
+		It("should return an error if the http request fails", func() {
+			db := errClientRequest.GetDatabase("non-existent-db-name")
+			query.Selector["Model"] = "Diablo"
+			_, err := db.Explain(query)
+			Ω(err).To(HaveOccurred())
+		})
+	})
+})
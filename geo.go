@@ -0,0 +1,180 @@
+package cloudant
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Geometric relations supported by cloudant geo queries
+// https://docs.cloudant.com/geo.html#querying-a-cloudant-geo-index
+const (
+	GeoContains         = "contains"
+	GeoContainsProperly = "contains_properly"
+	GeoCoveredBy        = "covered_by"
+	GeoCovers           = "covers"
+	GeoCrosses          = "crosses"
+	GeoDisjoint         = "disjoint"
+	GeoIntersects       = "intersects"
+	GeoOverlaps         = "overlaps"
+	GeoTouches          = "touches"
+	GeoWithin           = "within"
+)
+
+// Result formats supported by cloudant geo queries
+const (
+	GeoFormatGeoJSON = "geojson"
+	GeoFormatLegacy  = "legacy"
+)
+
+// Container for a geospatial index stored in a design document's st_indexes
+// https://docs.cloudant.com/geo.html#creating-a-cloudant-geo-index
+type GeoIndex struct {
+	Index string `json:"index"`
+}
+
+// A point used as the center of a radius query
+type GeoPoint struct {
+	Lat float64
+	Lon float64
+}
+
+// Container for cloudant geo query parameters
+// https://docs.cloudant.com/geo.html#querying-a-cloudant-geo-index
+type GeoQuery struct {
+	BBox        []float64 // min longitude, min latitude, max longitude, max latitude
+	Point       *GeoPoint
+	Radius      float64 // in meters, used together with Point
+	Geometry    string  // well known text (WKT)
+	Relation    string
+	Nearest     bool
+	Format      string
+	Bookmark    string
+	Limit       int
+	Skip        int
+	IncludeDocs bool
+}
+
+// GeoJSON geometry object
+type GeoGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// A single result of a geo query using the geojson format.
+// Embed it in a struct declaring a Doc field of the desired type in order to decode documents.
+type GeoFeature struct {
+	Type       string                 `json:"type"`
+	Id         string                 `json:"_id"`
+	Revision   string                 `json:"_rev"`
+	Geometry   GeoGeometry            `json:"geometry"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+// A single result of a geo query using the legacy format.
+// Embed it in a struct declaring a Doc field of the desired type in order to decode documents.
+type GeoRow struct {
+	Id       string      `json:"id"`
+	Revision string      `json:"rev"`
+	Geometry GeoGeometry `json:"geometry"`
+}
+
+func NewGeoQuery() *GeoQuery {
+	return &GeoQuery{Format: GeoFormatGeoJSON}
+}
+
+func (q *GeoQuery) values() url.Values {
+	v := url.Values{}
+
+	if len(q.BBox) > 0 {
+		coords := make([]string, len(q.BBox))
+		for i, c := range q.BBox {
+			coords[i] = strconv.FormatFloat(c, 'f', -1, 64)
+		}
+		v.Set("bbox", strings.Join(coords, ","))
+	}
+
+	if q.Point != nil {
+		v.Set("lat", strconv.FormatFloat(q.Point.Lat, 'f', -1, 64))
+		v.Set("lon", strconv.FormatFloat(q.Point.Lon, 'f', -1, 64))
+		if q.Radius > 0 {
+			v.Set("radius", strconv.FormatFloat(q.Radius, 'f', -1, 64))
+		}
+	}
+
+	if q.Geometry != "" {
+		v.Set("g", q.Geometry)
+	}
+
+	if q.Relation != "" {
+		v.Set("relation", q.Relation)
+	}
+
+	if q.Nearest {
+		v.Set("nearest", "true")
+	}
+
+	if q.Format != "" {
+		v.Set("format", q.Format)
+	}
+
+	if q.Bookmark != "" {
+		v.Set("bookmark", q.Bookmark)
+	}
+
+	if q.Limit > 0 {
+		v.Set("limit", strconv.Itoa(q.Limit))
+	}
+
+	if q.Skip > 0 {
+		v.Set("skip", strconv.Itoa(q.Skip))
+	}
+
+	if q.IncludeDocs {
+		v.Set("include_docs", "true")
+	}
+
+	return v
+}
+
+// Query a geospatial index.  Results are decoded into the provided slice of features
+// (geojson format) or rows (legacy format).  The returned bookmark can be used to fetch the next page.
+// https://docs.cloudant.com/geo.html#querying-a-cloudant-geo-index
+func (db *Database) GeoQuery(ddoc, index string, q *GeoQuery, results interface{}) (string, error) {
+	uri := db.docPath("_design/"+ddoc) + "/_geo/" + escapePathSegment(index) + "?" + q.values().Encode()
+	resp, err := db.client.doRequest("GET", uri, nil, nil)
+
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return "", newCloudantError(resp)
+	}
+
+	buf := new(bytes.Buffer)
+	_, err = buf.ReadFrom(resp.Body)
+
+	var objmap map[string]*json.RawMessage
+	if err == nil {
+		err = json.Unmarshal(buf.Bytes(), &objmap)
+	}
+
+	bookmark := ""
+	if err == nil && objmap["bookmark"] != nil {
+		err = json.Unmarshal(*objmap["bookmark"], &bookmark)
+	}
+
+	if err == nil {
+		if features := objmap["features"]; features != nil {
+			err = json.Unmarshal(*features, results)
+		} else if rows := objmap["rows"]; rows != nil {
+			err = json.Unmarshal(*rows, results)
+		}
+	}
+
+	return bookmark, err
+}
@@ -0,0 +1,120 @@
+package cloudant_test
+
+import (
+	"time"
+
+	. "github.com/obieq/go-cloudant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type CloudantVehicle struct {
+	CloudantDocument
+	Name     string
+	Geometry GeoGeometry `json:"geometry"`
+}
+
+type CloudantVehicleFeature struct {
+	GeoFeature
+	Doc CloudantVehicle `json:"doc"`
+}
+
+type CloudantVehicleRow struct {
+	GeoRow
+	Doc CloudantVehicle `json:"doc"`
+}
+
+var geoDDocName string = "geo_vehicles"
+var geoIndexName string = "geoidx"
+var geoDataCreated bool = false
+
+// NOTE: only create geo data once per test run
+func createTestGeoData() {
+	if geoDataCreated {
+		return
+	}
+
+	ddoc := &DesignDocument{StIndexes: map[string]GeoIndex{
+		geoIndexName: GeoIndex{Index: "function(doc) { if (doc.geometry && doc.geometry.coordinates) { st_index(doc.geometry); } }"},
+	}}
+	ddoc.SetId("_design/" + geoDDocName)
+
+	cdr, err := testDb.SaveDesignDocument(ddoc)
+	Ω(err).NotTo(HaveOccurred())
+	Ω(cdr.Id).Should(Equal("_design/" + geoDDocName))
+
+	truck := CloudantVehicle{Name: "truck", Geometry: GeoGeometry{Type: "Point", Coordinates: []byte("[-71.06, 42.36]")}}
+	van := CloudantVehicle{Name: "van", Geometry: GeoGeometry{Type: "Point", Coordinates: []byte("[-71.09, 42.35]")}}
+	bus := CloudantVehicle{Name: "bus", Geometry: GeoGeometry{Type: "Point", Coordinates: []byte("[-122.42, 37.77]")}}
+
+	for _, v := range []CloudantVehicle{truck, van, bus} {
+		CreateDocumentAndAssert(&v, "", false)
+	}
+
+	time.Sleep(indexCreateSleep * time.Millisecond)
+	geoDataCreated = true
+}
+
+var _ = Describe("Geo", func() {
+	var query *GeoQuery
+
+	BeforeEach(func() {
+		createTestGeoData()
+		query = NewGeoQuery()
+		query.IncludeDocs = true
+	})
+
+	Describe("Querying", func() {
+		It("should find documents within a bounding box", func() {
+			features := []CloudantVehicleFeature{}
+			query.BBox = []float64{-71.2, 42.3, -71.0, 42.4}
+
+			bookmark, err := testDb.GeoQuery(geoDDocName, geoIndexName, query, &features)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(bookmark).ShouldNot(BeEmpty())
+			Ω(len(features)).Should(Equal(2))
+			Ω(features[0].Geometry.Type).Should(Equal("Point"))
+			Ω(features[0].Doc.Name).ShouldNot(BeEmpty())
+		})
+
+		It("should find documents within a radius", func() {
+			features := []CloudantVehicleFeature{}
+			query.Point = &GeoPoint{Lat: 37.77, Lon: -122.42}
+			query.Radius = 1000
+
+			_, err := testDb.GeoQuery(geoDDocName, geoIndexName, query, &features)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(len(features)).Should(Equal(1))
+			Ω(features[0].Doc.Name).Should(Equal("bus"))
+		})
+
+		It("should find documents by geometry and relation", func() {
+			rows := []CloudantVehicleRow{}
+			query.Geometry = "POLYGON((-71.2 42.3,-71.0 42.3,-71.0 42.4,-71.2 42.4,-71.2 42.3))"
+			query.Relation = GeoContains
+			query.Format = GeoFormatLegacy
+
+			_, err := testDb.GeoQuery(geoDDocName, geoIndexName, query, &rows)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(len(rows)).Should(Equal(2))
+			Ω(rows[0].Id).ShouldNot(BeEmpty())
+		})
+	})
+
+	Describe("Error Handling", func() {
+		It("should return a 404 error for a non-existent index", func() {
+			features := []CloudantVehicleFeature{}
+			query.BBox = []float64{-71.2, 42.3, -71.0, 42.4}
+
+			_, err := testDb.GeoQuery(geoDDocName, "does_not_exist", query, &features)
+			Ω(err).To(HaveOccurred())
+			Ω(err.(*CloudantError).StatusCode).Should(Equal(404))
+		})
+
+		It("should return an error if the http request fails", func() {
+			db := errClientRequest.GetDatabase("non-existent-db-name")
+			_, err := db.GeoQuery(geoDDocName, geoIndexName, query, &[]GeoFeature{})
+			Ω(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,73 @@
+package cloudant
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// The raw response of an update handler, show function or list function.
+// Handlers may respond with any status code, so error status codes are returned here rather than as an error.
+type HandlerResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Invoke an update handler.  The document id is optional; without it the handler is called via POST.
+// Headers such as Content-Type can be provided for the body, which defaults to JSON.
+// http://docs.couchdb.org/en/stable/api/ddoc/render.html#db-design-design-doc-update-update-name
+func (db *Database) CallUpdateHandler(ddoc string, name string, docId string, headers map[string]string, body io.Reader) (*HandlerResponse, error) {
+	method := "POST"
+	uri := db.docPath("_design/"+ddoc) + "/_update/" + escapePathSegment(name)
+
+	if docId != "" {
+		method = "PUT"
+		uri += "/" + escapeDocId(docId)
+	}
+
+	return db.callHandler(method, uri, nil, headers, body)
+}
+
+// Invoke a show function.  The document id is optional.  Option values are sent as query parameters.
+// http://docs.couchdb.org/en/stable/api/ddoc/render.html#db-design-design-doc-show-show-name
+func (db *Database) CallShow(ddoc string, name string, docId string, opts map[string]string, headers map[string]string) (*HandlerResponse, error) {
+	uri := db.docPath("_design/"+ddoc) + "/_show/" + escapePathSegment(name)
+
+	if docId != "" {
+		uri += "/" + escapeDocId(docId)
+	}
+
+	return db.callHandler("GET", uri, opts, headers, nil)
+}
+
+// Invoke a list function on a view of the same design document.  Option values are sent as
+// query parameters, so view keys must be JSON encoded.
+// http://docs.couchdb.org/en/stable/api/ddoc/render.html#db-design-design-doc-list-list-name-view-name
+func (db *Database) CallList(ddoc string, name string, view string, opts map[string]string, headers map[string]string) (*HandlerResponse, error) {
+	uri := db.docPath("_design/"+ddoc) + "/_list/" + escapePathSegment(name) + "/" + escapePathSegment(view)
+	return db.callHandler("GET", uri, opts, headers, nil)
+}
+
+func (db *Database) callHandler(method string, uri string, opts map[string]string, headers map[string]string, body io.Reader) (*HandlerResponse, error) {
+	v := url.Values{}
+	for k, val := range opts {
+		v.Set(k, val)
+	}
+
+	if len(v) > 0 {
+		uri += "?" + v.Encode()
+	}
+
+	resp, err := db.client.doRequest(method, uri, headers, body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	hr := &HandlerResponse{StatusCode: resp.StatusCode, Header: resp.Header}
+	hr.Body, err = ioutil.ReadAll(resp.Body)
+
+	return hr, err
+}
@@ -0,0 +1,131 @@
+package cloudant_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	. "github.com/obieq/go-cloudant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var handlerDDocName string = "handlers"
+var handlerDbName string = "golang_suite_handlers"
+var handlerDb *Database
+var handlerDataCreated bool = false
+
+// NOTE: only create the handlers database and design document once per test run.
+// A separate database keeps the handler documents out of the shared query fixtures.
+func createTestHandlerDesignDocument() {
+	if handlerDataCreated {
+		return
+	}
+
+	testClient.DeleteDatabase(handlerDbName)
+	_, err := testClient.CreateDatabase(handlerDbName)
+	Ω(err).NotTo(HaveOccurred())
+
+	handlerDb, err = NewDatabase(handlerDbName, testClient)
+	Ω(err).NotTo(HaveOccurred())
+
+	// saved as a map b/c DesignDocument views only support cloudant query indexes
+	ddoc := map[string]interface{}{
+		"language": "javascript",
+		"updates": map[string]string{
+			"stamp": `function(doc, req) {
+				if (!doc) { doc = {_id: req.uuid, Make: "unknown"}; }
+				doc.Stamped = JSON.parse(req.body).stamp;
+				return [doc, {code: 201, headers: {"X-Stamped": "yes"}, body: doc._id}];
+			}`,
+		},
+		"shows": map[string]string{
+			"model": `function(doc, req) { return {code: doc ? 200 : 404, body: doc ? doc.Model : "missing"}; }`,
+		},
+		"lists": map[string]string{
+			"models": `function(head, req) { var row, models = []; while (row = getRow()) { models.push(row.key); } send(models.join(",")); }`,
+		},
+		"views": map[string]interface{}{
+			"by_model": map[string]string{"map": `function(doc) { if (doc.Make == "Porsche") { emit(doc.Model, null); } }`},
+		},
+	}
+
+	_, err = handlerDb.PutDocument("_design/"+handlerDDocName, ddoc)
+	Ω(err).NotTo(HaveOccurred())
+
+	for _, model := range []string{"911", "914"} {
+		_, err = handlerDb.CreateDocument(&CloudantAutomobile{Year: 1970, Make: "Porsche", Model: model}, false)
+		Ω(err).NotTo(HaveOccurred())
+	}
+
+	handlerDataCreated = true
+}
+
+var _ = Describe("Handler", func() {
+	BeforeEach(func() {
+		createTestHandlerDesignDocument()
+	})
+
+	Describe("Update handlers", func() {
+		It("should create a document without an id", func() {
+			hr, err := handlerDb.CallUpdateHandler(handlerDDocName, "stamp", "", nil, bytes.NewBufferString(`{"stamp": "created"}`))
+			Ω(err).NotTo(HaveOccurred())
+			Ω(hr.StatusCode).Should(Equal(201))
+			Ω(hr.Header.Get("X-Stamped")).Should(Equal("yes"))
+			Ω(hr.Body).ShouldNot(BeEmpty())
+		})
+
+		It("should update an existing document", func() {
+			id := GenerateRandomUUID()
+			_, err := handlerDb.PutDocument(id, map[string]interface{}{"Make": "Porsche", "Model": "356"})
+			Ω(err).NotTo(HaveOccurred())
+
+			hr, err := handlerDb.CallUpdateHandler(handlerDDocName, "stamp", id, nil, bytes.NewBufferString(`{"stamp": "updated"}`))
+			Ω(err).NotTo(HaveOccurred())
+			Ω(hr.StatusCode).Should(Equal(201))
+			Ω(string(hr.Body)).Should(Equal(id))
+
+			doc := make(map[string]interface{})
+			err = handlerDb.GetDocument(id, &doc)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(doc["Stamped"]).Should(Equal("updated"))
+		})
+	})
+
+	Describe("Show functions", func() {
+		It("should show a document", func() {
+			id := GenerateRandomUUID()
+			_, err := handlerDb.PutDocument(id, map[string]interface{}{"Make": "Porsche", "Model": "959"})
+			Ω(err).NotTo(HaveOccurred())
+
+			hr, err := handlerDb.CallShow(handlerDDocName, "model", id, nil, map[string]string{"Accept": "text/html"})
+			Ω(err).NotTo(HaveOccurred())
+			Ω(hr.StatusCode).Should(Equal(200))
+			Ω(string(hr.Body)).Should(Equal("959"))
+		})
+
+		It("should return the handler's status code", func() {
+			hr, err := handlerDb.CallShow(handlerDDocName, "model", "", nil, nil)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(hr.StatusCode).Should(Equal(404))
+			Ω(string(hr.Body)).Should(Equal("missing"))
+		})
+	})
+
+	Describe("List functions", func() {
+		It("should list a view", func() {
+			startkey, _ := json.Marshal("911")
+			hr, err := handlerDb.CallList(handlerDDocName, "models", "by_model", map[string]string{"startkey": string(startkey)}, nil)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(hr.StatusCode).Should(Equal(200))
+			Ω(string(hr.Body)).Should(Equal("911,914"))
+		})
+	})
+
+	Describe("Error Handling", func() {
+		It("should return an error if the http request fails", func() {
+			db := errClientRequest.GetDatabase("non-existent-db-name")
+			_, err := db.CallShow(handlerDDocName, "model", "", nil, nil)
+			Ω(err).To(HaveOccurred())
+		})
+	})
+})
@@ -22,44 +22,133 @@ type indexDefinition struct {
 	Fields []map[string]string `json:"fields"`
 }
 
+// Container for the definition of a new index
+// http://docs.cloudant.com/api/cloudant-query.html?highlight=query#creating-a-new-index
+type IndexDefinition struct {
+	Fields                []IndexField
+	DDocName              string                 // auto-generated if not specified
+	Name                  string                 // auto-generated if not specified
+	Type                  string                 // json (default) or text
+	PartialFilterSelector map[string]interface{} // only documents matching the selector are indexed
+	Partitioned           *bool                  // defaults to the partitioning of the database
+}
+
+// A field to be indexed along with its sort direction, or its type for text indexes
+type IndexField struct {
+	Name       string
+	Descending bool   // json indexes only
+	Type       string // text indexes only: string (default), number or boolean
+}
+
+// Result of creating an index
+type IndexResponse struct {
+	Result string `json:"result"` // created or exists
+	Id     string `json:"id"`     // id of the design document containing the index
+	Name   string `json:"name"`
+}
+
+type indexRequest struct {
+	Index       indexRequestIndex `json:"index"`
+	DDoc        string            `json:"ddoc,omitempty"`
+	Name        string            `json:"name,omitempty"`
+	Type        string            `json:"type,omitempty"`
+	Partitioned *bool             `json:"partitioned,omitempty"`
+}
+
+type indexRequestIndex struct {
+	Fields                []interface{}          `json:"fields,omitempty"`
+	PartialFilterSelector map[string]interface{} `json:"partial_filter_selector,omitempty"`
+}
+
+func NewIndexDefinition(fields ...string) *IndexDefinition {
+	def := &IndexDefinition{}
+	for _, f := range fields {
+		def.AddField(f, false)
+	}
+
+	return def
+}
+
+func (def *IndexDefinition) AddField(name string, isDescending bool) {
+	def.Fields = append(def.Fields, IndexField{Name: name, Descending: isDescending})
+}
+
+// Add a field to a text index.  A text index without fields indexes all fields.
+func (def *IndexDefinition) AddTextField(name string, fieldType string) {
+	def.Fields = append(def.Fields, IndexField{Name: name, Type: fieldType})
+}
+
+func (def *IndexDefinition) MarshalJSON() ([]byte, error) {
+	ir := indexRequest{
+		Index: indexRequestIndex{
+			Fields:                make([]interface{}, 0, len(def.Fields)),
+			PartialFilterSelector: def.PartialFilterSelector,
+		},
+		DDoc:        def.DDocName,
+		Name:        def.Name,
+		Type:        def.Type,
+		Partitioned: def.Partitioned,
+	}
+
+	for _, f := range def.Fields {
+		// text index fields have the form {"name": "Make", "type": "string"}
+		if def.Type == "text" {
+			fieldType := f.Type
+			if fieldType == "" {
+				fieldType = "string"
+			}
+			ir.Index.Fields = append(ir.Index.Fields, map[string]string{"name": f.Name, "type": fieldType})
+			continue
+		}
+
+		direction := "asc"
+		if f.Descending {
+			direction = "desc"
+		}
+		ir.Index.Fields = append(ir.Index.Fields, map[string]string{f.Name: direction})
+	}
+
+	return json.Marshal(ir)
+}
+
 // Create an index on the specified field names
 //  http://docs.cloudant.com/api/cloudant-query.html?highlight=query#creating-a-new-index
 //
 //  Options:
 //    1) ddoc_name:  name of the design document.  auto-generated if not specified
 //    2) index_name: name of the index auto-generate if not specified
-//    3) type:       currently, only supported type is json (default).
-//                   per the docs, full text and geospatial will be available at some point
+//    3) type:       json (default) or text.  text index fields are indexed as strings
 func (db *Database) CreateIndex(fields []string, opts map[string]string) (CloudantDocumentResponse, error) {
-	cdr := CloudantDocumentResponse{}
+	def := NewIndexDefinition(fields...)
+	def.DDocName = opts["ddoc_name"]
+	def.Name = opts["index_name"]
+	def.Type = opts["type"]
 
-	json, _ := json.Marshal(fields) // given that fields is a string array, json.Marshal shouldn't raise an error
+	ir, err := db.CreateIndexFromDefinition(def)
 
-	// set fields names to be indexed
-	str := `"index": {"fields": ` + string(json) + `}`
+	return CloudantDocumentResponse{Id: ir.Id, Result: ir.Result}, err
+}
 
-	// set design document name?
-	if ddoc_name := opts["ddoc_name"]; ddoc_name != "" {
-		str += `, "ddoc": "` + ddoc_name + `"`
-	}
+// Create an index from a typed definition
+//  http://docs.cloudant.com/api/cloudant-query.html?highlight=query#creating-a-new-index
+func (db *Database) CreateIndexFromDefinition(def *IndexDefinition) (IndexResponse, error) {
+	ir := IndexResponse{}
 
-	// set index name?
-	if index_name := opts["index_name"]; index_name != "" {
-		str += `, "name": "` + index_name + `"`
+	j, err := json.Marshal(def)
+	if err != nil {
+		return ir, err
 	}
 
-	body := []byte("{" + str + "}")
-
-	resp, err := db.client.doRequest("POST", "/"+db.Name()+"/_index", nil, bytes.NewReader(body))
-	err = db.client.handleResponse(resp, err, 200, &cdr)
+	resp, err := db.client.doRequest("POST", db.path()+"/_index", nil, bytes.NewReader(j))
+	err = db.client.handleResponse(resp, err, 200, &ir)
 
-	return cdr, err
+	return ir, err
 }
 
 func (db *Database) GetIndices() ([]Index, error) {
 	il := indexList{}
 
-	resp, err := db.client.doRequest("GET", "/"+db.Name()+"/_index", nil, nil)
+	resp, err := db.client.doRequest("GET", db.path()+"/_index", nil, nil)
 	err = db.client.handleResponse(resp, err, 200, &il)
 
 	return il.Indices, err
@@ -104,7 +193,7 @@ func (db *Database) DeleteIndexByName(name string) (CloudantDocumentResponse, er
 		return cdr, err
 	}
 
-	uri := "/" + db.Name() + "/_index/" + index.DDocId + "/" + index.Type + "/" + name
+	uri := db.path() + "/_index/" + escapeDocId(index.DDocId) + "/" + escapePathSegment(index.Type) + "/" + escapePathSegment(name)
 	resp, err := db.client.doRequest("DELETE", uri, nil, nil)
 	err = db.client.handleResponse(resp, err, 200, &cdr)
 
@@ -153,6 +153,58 @@ var _ = Describe("Index", func() {
 			})
 		})
 
+		Context("With index definition", func() {
+			It("should create with sort direction and partial filter selector", func() {
+				def := NewIndexDefinition()
+				def.AddField("DefinitionYear", true)
+				def.AddField("DefinitionMake", true)
+				def.Name = "idx_definition_year_make"
+				def.DDocName = "idx_definition_year_make"
+				def.PartialFilterSelector = map[string]interface{}{"DefinitionYear": map[string]interface{}{"$gt": 2000}}
+
+				ir, err := testDb.CreateIndexFromDefinition(def)
+				Ω(err).NotTo(HaveOccurred())
+				Ω(ir.Result).Should(Equal("created"))
+				Ω(ir.Id).Should(Equal("_design/idx_definition_year_make"))
+				Ω(ir.Name).Should(Equal("idx_definition_year_make"))
+
+				// verify
+				time.Sleep(indexCreateSleep * time.Millisecond)
+				index, err := testDb.GetIndexByName(def.Name)
+				Ω(err).NotTo(HaveOccurred())
+				Ω(index.Definition.Fields[0]["DefinitionYear"]).Should(Equal("desc"))
+			})
+
+			It("should create a text index with typed fields", func() {
+				def := NewIndexDefinition()
+				def.Type = "text"
+				def.AddTextField("DefinitionNotes", "string")
+				def.AddTextField("DefinitionMileage", "number")
+				def.Name = "idx_definition_text"
+
+				ir, err := testDb.CreateIndexFromDefinition(def)
+				Ω(err).NotTo(HaveOccurred())
+				Ω(ir.Result).Should(Equal("created"))
+
+				// verify
+				time.Sleep(indexCreateSleep * time.Millisecond)
+				index, err := testDb.GetIndexByName(def.Name)
+				Ω(err).NotTo(HaveOccurred())
+				Ω(index.Type).Should(Equal("text"))
+				Ω(len(index.Definition.Fields)).Should(Equal(2))
+			})
+
+			It("should create with a quoted index name", func() {
+				def := NewIndexDefinition("DefinitionQuoted")
+				def.Name = `idx_"quoted"`
+
+				ir, err := testDb.CreateIndexFromDefinition(def)
+				Ω(err).NotTo(HaveOccurred())
+				Ω(ir.Result).Should(Equal("created"))
+				Ω(ir.Name).Should(Equal(def.Name))
+			})
+		})
+
 		It("should not create an index if an index by the same name exists", func() {
 			opts := make(map[string]string)
 			opts["index_name"] = idx_ddoc_and_index_name
@@ -196,6 +248,17 @@ var _ = Describe("Index", func() {
 	})
 
 	Describe("Error Handling", func() {
+		Context("Creating index from definition", func() {
+			It("should return an error if the partial filter selector fails json.Marshal", func() {
+				def := NewIndexDefinition("DefinitionInvalid")
+				def.PartialFilterSelector = GenerateInvalidJson()
+
+				_, err := testDb.CreateIndexFromDefinition(def)
+				Ω(err).To(HaveOccurred())
+				Ω(err.Error()).Should(ContainSubstring("json: unsupported type: map[int]interface {}"))
+			})
+		})
+
 		Context("Getting index by name", func() {
 			It("should return an error if the http request fails", func() {
 				db := errClientRequest.GetDatabase("non-existent-db-name")
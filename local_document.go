@@ -0,0 +1,57 @@
+package cloudant
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+// Local documents are not replicated, have no revision tree and are not returned by _changes.
+// Their revisions have the form 0-N.
+// http://docs.couchdb.org/en/stable/api/local.html
+const localDocPrefix = "_local/"
+
+// Add the _local/ prefix to the id unless it's already present
+func localDocId(id string) string {
+	if strings.HasPrefix(id, localDocPrefix) {
+		return id
+	}
+
+	return localDocPrefix + id
+}
+
+// Get a local document.  The _local/ prefix of the id is optional.
+func (db *Database) GetLocal(id string, doc interface{}) error {
+	resp, err := db.client.doRequest("GET", db.docPath(localDocId(id)), nil, nil)
+	return db.client.handleResponse(resp, err, 200, doc)
+}
+
+// Create or update a local document.  The _local/ prefix of the id is optional.
+// Updates must include the current revision in the document's _rev.
+func (db *Database) PutLocal(id string, doc interface{}) (CloudantDocumentResponse, error) {
+	cdr := CloudantDocumentResponse{}
+
+	j, err := json.Marshal(doc)
+	if err != nil {
+		return cdr, err
+	}
+
+	resp, err := db.client.doRequest("PUT", db.docPath(localDocId(id)), nil, bytes.NewReader(j))
+	err = db.client.handleResponse(resp, err, 201, &cdr)
+	return cdr, err
+}
+
+// Delete a local document.  The _local/ prefix of the id is optional.
+func (db *Database) DeleteLocal(id string, revision string) (CloudantDocumentResponse, error) {
+	cdr := CloudantDocumentResponse{}
+
+	resp, err := db.client.doRequest("DELETE", db.docPath(localDocId(id))+"?rev="+url.QueryEscape(revision), nil, nil)
+	err = db.client.handleResponse(resp, err, 200, &cdr)
+	return cdr, err
+}
+
+// List local documents.  Option values are sent as is, e.g. opts["include_docs"] = "true"
+func (db *Database) ListLocalDocs(opts map[string]string, rows interface{}) (ViewResults, error) {
+	return db.client.getRows(db.path()+"/_local_docs", opts, rows)
+}
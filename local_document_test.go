@@ -0,0 +1,62 @@
+package cloudant_test
+
+import (
+	. "github.com/obieq/go-cloudant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type CloudantCheckpoint struct {
+	CloudantDocument
+	Seq string
+}
+
+var _ = Describe("LocalDocument", func() {
+	var checkpointId string
+
+	BeforeEach(func() {
+		checkpointId = "checkpoint_" + GenerateRandomUUID()
+	})
+
+	It("should put, get, list and delete a local document", func() {
+		checkpoint := CloudantCheckpoint{Seq: "1-abc"}
+		cdr, err := testDb.PutLocal(checkpointId, &checkpoint)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(cdr.Id).Should(Equal("_local/" + checkpointId))
+		Ω(cdr.Revision).Should(Equal("0-1"))
+
+		err = testDb.GetLocal("_local/"+checkpointId, &checkpoint)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(checkpoint.Id()).Should(Equal("_local/" + checkpointId))
+		Ω(checkpoint.Revision()).Should(Equal("0-1"))
+
+		checkpoint.Seq = "2-def"
+		cdr, err = testDb.PutLocal(checkpointId, &checkpoint)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(cdr.Revision).Should(Equal("0-2"))
+
+		rows := []CloudantAllDocsRow{}
+		_, err = testDb.ListLocalDocs(nil, &rows)
+		Ω(err).NotTo(HaveOccurred())
+		ids := []string{}
+		for _, row := range rows {
+			ids = append(ids, row.Id)
+		}
+		Ω(ids).Should(ContainElement("_local/" + checkpointId))
+
+		_, err = testDb.DeleteLocal(checkpointId, cdr.Revision)
+		Ω(err).NotTo(HaveOccurred())
+
+		err = testDb.GetLocal(checkpointId, &checkpoint)
+		Ω(err).To(HaveOccurred())
+		Ω(err.(*CloudantError).StatusCode).Should(Equal(404))
+	})
+
+	Describe("Error Handling", func() {
+		It("should return an error if the document fails json.Marshal", func() {
+			_, err := testDb.PutLocal(checkpointId, GenerateInvalidJson())
+			Ω(err).To(HaveOccurred())
+			Ω(err.Error()).Should(Equal("json: unsupported type: map[int]interface {}"))
+		})
+	})
+})
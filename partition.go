@@ -0,0 +1,103 @@
+package cloudant
+
+import (
+	"context"
+	"strings"
+)
+
+// A handle scoping requests to a single partition of a partitioned database
+// http://docs.cloudant.com/api/partitioned-databases.html
+type Partition struct {
+	key string
+	db  *Database
+}
+
+// Container for partition information
+type PartitionInfo struct {
+	DbName      string         `json:"db_name"`
+	Partition   string         `json:"partition"`
+	DocCount    int            `json:"doc_count"`
+	DocDelCount int            `json:"doc_del_count"`
+	Sizes       PartitionSizes `json:"sizes"`
+}
+
+type PartitionSizes struct {
+	Active   int64 `json:"active"`
+	External int64 `json:"external"`
+}
+
+func (db *Database) Partition(key string) *Partition {
+	return &Partition{key: key, db: db}
+}
+
+func (p *Partition) Key() string {
+	return p.key
+}
+
+func (p *Partition) Database() *Database {
+	return p.db
+}
+
+func (p *Partition) path() string {
+	return p.db.path() + "/_partition/" + escapePathSegment(p.key)
+}
+
+// Verify that a document id has the form partition:docid.  Design and local documents are exempt.
+func ValidatePartitionedDocId(id string) error {
+	if strings.HasPrefix(id, "_design/") || strings.HasPrefix(id, "_local/") {
+		return nil
+	}
+
+	i := strings.Index(id, ":")
+	if i <= 0 || i == len(id)-1 {
+		return &ValidationError{Field: "partitioned document id", Value: id, Reason: "must have the form partition:docid"}
+	}
+
+	if strings.HasPrefix(id, "_") {
+		return &ValidationError{Field: "partitioned document id", Value: id, Reason: "partition key can not begin with an underscore"}
+	}
+
+	return nil
+}
+
+func (p *Partition) GetPartitionInfo() (*PartitionInfo, error) {
+	pi := &PartitionInfo{}
+
+	resp, err := p.db.client.doRequest("GET", p.path(), nil, nil)
+	err = p.db.client.handleResponse(resp, err, 200, pi)
+
+	return pi, err
+}
+
+// Create a document in the partition.  The document id must be set and prefixed with the partition key.
+func (p *Partition) CreateDocument(doc CloudantDocumentInterfacer, isBatch bool) (CloudantDocumentResponse, error) {
+	if err := ValidatePartitionedDocId(doc.Id()); err != nil {
+		return CloudantDocumentResponse{}, err
+	}
+
+	if !strings.HasPrefix(doc.Id(), p.key+":") {
+		return CloudantDocumentResponse{}, &ValidationError{Field: "partitioned document id", Value: doc.Id(), Reason: "must begin with partition key " + p.key}
+	}
+
+	return p.db.CreateDocument(doc, isBatch)
+}
+
+func (p *Partition) Query(q *Query, results interface{}) (QueryResults, error) {
+	return p.db.client.find(context.Background(), p.path()+"/_find", q, results)
+}
+
+// Get the documents of the partition via _all_docs.  Option values are sent as is,
+// so keys must be JSON encoded, e.g. opts["include_docs"] = "true"; opts["startkey"] = `"p1:a"`
+func (p *Partition) AllDocs(opts map[string]string, rows interface{}) (ViewResults, error) {
+	return p.db.client.getRows(p.path()+"/_all_docs", opts, rows)
+}
+
+// Query a view, scoped to the partition.  See AllDocs for option encoding.
+func (p *Partition) View(ddoc string, view string, opts map[string]string, rows interface{}) (ViewResults, error) {
+	return p.db.client.getRows(p.path()+"/"+escapeDocId("_design/"+ddoc)+"/_view/"+escapePathSegment(view), opts, rows)
+}
+
+// Query a search index, scoped to the partition.  The lucene query is passed in opts["q"].
+func (p *Partition) Search(ddoc string, index string, opts map[string]string, rows interface{}) (ViewResults, error) {
+	return p.db.client.getRows(p.path()+"/"+escapeDocId("_design/"+ddoc)+"/_search/"+escapePathSegment(index), opts, rows)
+}
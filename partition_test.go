@@ -0,0 +1,123 @@
+package cloudant_test
+
+import (
+	. "github.com/obieq/go-cloudant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type CloudantAllDocsRow struct {
+	Id  string             `json:"id"`
+	Key string             `json:"key"`
+	Doc CloudantAutomobile `json:"doc"`
+}
+
+var _ = Describe("Partition", func() {
+	var (
+		partitionedDb     *Database
+		partitionedDbName string = "golang_suite_partitioned"
+		partition         *Partition
+	)
+
+	BeforeEach(func() {
+		testClient.DeleteDatabase(partitionedDbName)
+		_, err := testClient.CreateDatabaseWithOptions(partitionedDbName, DatabaseOptions{Partitioned: true})
+		Ω(err).NotTo(HaveOccurred())
+
+		partitionedDb, err = NewDatabase(partitionedDbName, testClient)
+		Ω(err).NotTo(HaveOccurred())
+		partition = partitionedDb.Partition("fleet1")
+		Ω(partition.Key()).Should(Equal("fleet1"))
+		Ω(partition.Database()).Should(Equal(partitionedDb))
+
+		for _, id := range []string{"fleet1:truck", "fleet1:van"} {
+			auto := CloudantAutomobile{Year: 2015, Make: "Ford", Model: "Transit"}
+			auto.SetId(id)
+			_, err := partition.CreateDocument(&auto, false)
+			Ω(err).NotTo(HaveOccurred())
+		}
+
+		other := CloudantAutomobile{Year: 2015, Make: "Ford", Model: "F-150"}
+		other.SetId("fleet2:pickup")
+		_, err = partitionedDb.CreateDocument(&other, false)
+		Ω(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		testClient.DeleteDatabase(partitionedDbName)
+	})
+
+	It("should get partition info", func() {
+		pi, err := partition.GetPartitionInfo()
+		Ω(err).NotTo(HaveOccurred())
+		Ω(pi.DbName).Should(Equal(partitionedDbName))
+		Ω(pi.Partition).Should(Equal("fleet1"))
+		Ω(pi.DocCount).Should(Equal(2))
+	})
+
+	It("should get all docs of the partition", func() {
+		rows := []CloudantAllDocsRow{}
+		vr, err := partition.AllDocs(map[string]string{"include_docs": "true"}, &rows)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(len(rows)).Should(Equal(2))
+		Ω(vr.TotalRows).Should(Equal(2))
+		Ω(rows[0].Id).Should(Equal("fleet1:truck"))
+		Ω(rows[0].Doc.Model).Should(Equal("Transit"))
+	})
+
+	It("should query the partition", func() {
+		results := []CloudantAutomobile{}
+		query := NewQuery()
+		query.Selector = Field("Make").Eq("Ford")
+
+		_, err := partition.Query(query, &results)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(len(results)).Should(Equal(2))
+	})
+
+	Describe("Validating", func() {
+		It("should accept partitioned document ids", func() {
+			Ω(ValidatePartitionedDocId("fleet1:truck")).NotTo(HaveOccurred())
+			Ω(ValidatePartitionedDocId("_design/fleet")).NotTo(HaveOccurred())
+			Ω(ValidatePartitionedDocId("_local/checkpoint")).NotTo(HaveOccurred())
+		})
+
+		It("should reject invalid document ids", func() {
+			for _, id := range []string{"truck", ":truck", "fleet1:", "_fleet1:truck"} {
+				Ω(ValidatePartitionedDocId(id)).To(HaveOccurred())
+			}
+		})
+
+		It("should not create a document in another partition", func() {
+			auto := CloudantAutomobile{Year: 2015, Make: "Ford", Model: "Transit"}
+			auto.SetId("fleet2:truck")
+			_, err := partition.CreateDocument(&auto, false)
+			Ω(err).To(HaveOccurred())
+
+			auto.SetId("truck")
+			_, err = partition.CreateDocument(&auto, false)
+			Ω(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Error Handling", func() {
+		It("should return a 404 error for a non-existent view", func() {
+			_, err := partition.View("does_not_exist", "does_not_exist", nil, &[]CloudantAllDocsRow{})
+			Ω(err).To(HaveOccurred())
+			Ω(err.(*CloudantError).StatusCode).Should(Equal(404))
+		})
+
+		It("should return a 404 error for a non-existent search index", func() {
+			_, err := partition.Search("does_not_exist", "does_not_exist", map[string]string{"q": "*:*"}, &[]CloudantAllDocsRow{})
+			Ω(err).To(HaveOccurred())
+			Ω(err.(*CloudantError).StatusCode).Should(Equal(404))
+		})
+
+		It("should return an error if the http request fails", func() {
+			db := errClientRequest.GetDatabase("non-existent-db-name")
+			p := db.Partition("fleet1")
+			_, err := p.GetPartitionInfo()
+			Ω(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,50 @@
+package cloudant
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+)
+
+// Result of purging document revisions
+// http://docs.couchdb.org/en/stable/api/database/misc.html#db-purge
+type PurgeResponse struct {
+	PurgeSeq interface{}         `json:"purge_seq"`
+	Purged   map[string][]string `json:"purged"` // document id => purged revisions
+}
+
+// Permanently remove document revisions.  Unlike DeleteDocument, no tombstone is left behind.
+// A purge is also successful if it was accepted without reaching the write quorum (202).
+func (db *Database) Purge(revs map[string][]string) (PurgeResponse, error) {
+	pr := PurgeResponse{}
+
+	j, err := json.Marshal(revs)
+	if err != nil {
+		return pr, err
+	}
+
+	resp, err := db.client.doRequest("POST", db.path()+"/_purge", nil, bytes.NewReader(j))
+	successStatusCode := 201
+	if err == nil && resp.StatusCode == 202 {
+		successStatusCode = 202
+	}
+
+	err = db.client.handleResponse(resp, err, successStatusCode, &pr)
+	return pr, err
+}
+
+// Get the number of purge requests that are tracked by the database.
+func (db *Database) GetPurgedInfosLimit() (int, error) {
+	limit := 0
+
+	resp, err := db.client.doRequest("GET", db.path()+"/_purged_infos_limit", nil, nil)
+	err = db.client.handleResponse(resp, err, 200, &limit)
+	return limit, err
+}
+
+func (db *Database) SetPurgedInfosLimit(limit int) error {
+	ok := okResponse{}
+
+	resp, err := db.client.doRequest("PUT", db.path()+"/_purged_infos_limit", nil, bytes.NewReader([]byte(strconv.Itoa(limit))))
+	return db.client.handleResponse(resp, err, 200, &ok)
+}
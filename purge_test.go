@@ -0,0 +1,55 @@
+package cloudant_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Purge", func() {
+	It("should purge a document", func() {
+		auto := CloudantAutomobile{Year: 1957, Make: "Jaguar", Model: "XKSS"}
+		autoId := GenerateRandomUUID()
+		auto.SetId(autoId)
+
+		cdr, err := testDb.CreateDocument(&auto, false)
+		Ω(err).NotTo(HaveOccurred())
+
+		pr, err := testDb.Purge(map[string][]string{autoId: []string{cdr.Revision}})
+		Ω(err).NotTo(HaveOccurred())
+		Ω(pr.Purged[autoId]).Should(Equal([]string{cdr.Revision}))
+
+		exists, err := testDb.DocumentExists(autoId)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(exists).Should(BeFalse())
+	})
+
+	It("should get and set the purged infos limit", func() {
+		original, err := testDb.GetPurgedInfosLimit()
+		Ω(err).NotTo(HaveOccurred())
+		Ω(original).Should(BeNumerically(">", 0))
+
+		err = testDb.SetPurgedInfosLimit(original + 1)
+		Ω(err).NotTo(HaveOccurred())
+
+		limit, err := testDb.GetPurgedInfosLimit()
+		Ω(err).NotTo(HaveOccurred())
+		Ω(limit).Should(Equal(original + 1))
+
+		err = testDb.SetPurgedInfosLimit(original)
+		Ω(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Error Handling", func() {
+		It("should return an error if the http request fails", func() {
+			db := errClientRequest.GetDatabase("non-existent-db-name")
+			_, err := db.Purge(map[string][]string{"id": []string{"1-abc"}})
+			Ω(err).To(HaveOccurred())
+
+			_, err = db.GetPurgedInfosLimit()
+			Ω(err).To(HaveOccurred())
+
+			err = db.SetPurgedInfosLimit(1000)
+			Ω(err).To(HaveOccurred())
+		})
+	})
+})
@@ -2,20 +2,42 @@ package cloudant
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 )
 
 // Container for cloudant query selectors
 // http://docs.cloudant.com/api/cloudant-query.html?highlight=query#selector-syntax
 type Query struct {
-	Selector map[string]interface{} `json:"selector"`
-	Sorters  []map[string]string    `json:"sort"`
-	Limit    int                    `json:"limit"`
-	Skip     int                    `json:"skip"`
+	Selector       map[string]interface{} `json:"selector"`
+	Fields         []string               `json:"fields,omitempty"`
+	Sorters        []map[string]string    `json:"sort"`
+	Limit          int                    `json:"limit"`
+	Skip           int                    `json:"skip"`
+	Bookmark       string                 `json:"bookmark,omitempty"`
+	UseIndex       interface{}            `json:"use_index,omitempty"` // design document name or [design document name, index name]
+	R              int                    `json:"r,omitempty"`
+	Conflicts      bool                   `json:"conflicts,omitempty"`
+	Update         *bool                  `json:"update,omitempty"`
+	Stable         bool                   `json:"stable,omitempty"`
+	ExecutionStats bool                   `json:"execution_stats,omitempty"`
 }
 
+// Container for cloudant query results.  Docs holds the receiver passed to Database.Query.
 type QueryResults struct {
-	Docs interface{} `json:"docs"`
+	Docs           interface{}     `json:"docs"`
+	Bookmark       string          `json:"bookmark"`
+	Warning        string          `json:"warning"`
+	ExecutionStats *ExecutionStats `json:"execution_stats"`
+}
+
+// Statistics returned when Query.ExecutionStats is set
+type ExecutionStats struct {
+	TotalKeysExamined       int     `json:"total_keys_examined"`
+	TotalDocsExamined       int     `json:"total_docs_examined"`
+	TotalQuorumDocsExamined int     `json:"total_quorum_docs_examined"`
+	ResultsReturned         int     `json:"results_returned"`
+	ExecutionTimeMs         float64 `json:"execution_time_ms"`
 }
 
 func NewQuery() *Query {
@@ -34,22 +56,40 @@ func (q *Query) Sort(field string, isAscending bool) {
 	q.Sorters = append(q.Sorters, sort)
 }
 
-func (db *Database) Query(q *Query, results interface{}) error {
+// Instruct cloudant to use a specific index.  The index name is optional.
+func (q *Query) SetUseIndex(ddocName string, indexName string) {
+	if indexName == "" {
+		q.UseIndex = ddocName
+	} else {
+		q.UseIndex = []string{ddocName, indexName}
+	}
+}
+
+func (db *Database) Query(q *Query, results interface{}) (QueryResults, error) {
+	return db.queryWithContext(context.Background(), q, results)
+}
+
+func (db *Database) queryWithContext(ctx context.Context, q *Query, results interface{}) (QueryResults, error) {
+	return db.client.find(ctx, db.path()+"/_find", q, results)
+}
+
+func (client *Client) find(ctx context.Context, uri string, q *Query, results interface{}) (QueryResults, error) {
+	qr := QueryResults{Docs: results}
+
 	j, err := json.Marshal(q)
 	if err != nil {
-		return err
+		return qr, err
 	}
 
-	body := []byte(string(j))
-	resp, err := db.client.doRequest("POST", "/"+db.Name()+"/_find", nil, bytes.NewReader(body))
+	resp, err := client.doRequestWithContext(ctx, "POST", uri, nil, bytes.NewReader(j))
 
 	if err != nil {
-		return err
+		return qr, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
-		return newCloudantError(resp)
+		return qr, newCloudantError(resp)
 	}
 
 	buf := new(bytes.Buffer)
@@ -57,15 +97,9 @@ func (db *Database) Query(q *Query, results interface{}) error {
 
 	//fmt.Printf(buf.String()) // NOTE: uncomment this line when debugging results
 
-	// for test coverage purposes, performing nil check using non-idiomatic pattern
-	var objmap map[string]*json.RawMessage
-	if err == nil {
-		err = json.Unmarshal(buf.Bytes(), &objmap)
-	}
-
 	if err == nil {
-		err = json.Unmarshal(*objmap["docs"], results)
+		err = json.Unmarshal(buf.Bytes(), &qr)
 	}
 
-	return err
+	return qr, err
 }
@@ -0,0 +1,94 @@
+package cloudant
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Number of documents fetched per request by a QueryIterator unless PageSize is set
+const DefaultQueryPageSize = 200
+
+// Walks all documents matching a query, following the bookmark returned by
+// cloudant from one page to the next.  Call Next until it returns false, then check Err.
+type QueryIterator struct {
+	PageSize int // documents fetched per request; set before the first call to Next
+
+	db    *Database
+	ctx   context.Context
+	query Query
+	docs  []json.RawMessage
+	pos   int
+	done  bool
+	err   error
+}
+
+// Create an iterator over all documents matching the query, fetched DefaultQueryPageSize
+// documents at a time.  The query's Limit is not used.  Iteration stops when the context is cancelled.
+func (db *Database) QueryIter(ctx context.Context, q *Query) *QueryIterator {
+	return &QueryIterator{PageSize: DefaultQueryPageSize, db: db, ctx: ctx, query: *q}
+}
+
+// Decode the next document into doc.  Returns false once all documents have been
+// read or an error occurred, in which case Err returns the error.
+func (it *QueryIterator) Next(doc interface{}) bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.pos >= len(it.docs) {
+		if it.done || !it.fetch() {
+			return false
+		}
+	}
+
+	if it.err = json.Unmarshal(it.docs[it.pos], doc); it.err != nil {
+		return false
+	}
+	it.pos++
+
+	return true
+}
+
+// The error, if any, that stopped the iteration.
+func (it *QueryIterator) Err() error {
+	return it.err
+}
+
+// The bookmark of the most recently fetched page.  It can be used to resume
+// iteration with a new query.
+func (it *QueryIterator) Bookmark() string {
+	return it.query.Bookmark
+}
+
+// Fetch the next page.  Returns false if there are no more documents.
+func (it *QueryIterator) fetch() bool {
+	if it.err = it.ctx.Err(); it.err != nil {
+		return false
+	}
+
+	it.query.Limit = it.PageSize
+	if it.query.Limit <= 0 {
+		it.query.Limit = DefaultQueryPageSize
+	}
+
+	docs := []json.RawMessage{}
+	qr, err := it.db.queryWithContext(it.ctx, &it.query, &docs)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	// a short page is the last page
+	if len(docs) < it.query.Limit || qr.Bookmark == "" {
+		it.done = true
+	}
+
+	// the bookmark already accounts for skipped documents
+	it.query.Bookmark = qr.Bookmark
+	it.query.Skip = 0
+
+	it.docs = docs
+	it.pos = 0
+
+	return len(docs) > 0
+}
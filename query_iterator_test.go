@@ -0,0 +1,95 @@
+package cloudant_test
+
+import (
+	"context"
+
+	. "github.com/obieq/go-cloudant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("QueryIterator", func() {
+	var query *Query
+
+	BeforeEach(func() {
+		// create test query data (will only be performed once per test run)
+		createTestQueryDataWithIndices()
+
+		query = NewQuery()
+		// other tests add documents to the shared test database, so only match the query fixtures (Year >= 2000)
+		query.Selector = Field("Model").Gt("1111").And(Field("Year").Gte(2000))
+	})
+
+	It("should iterate over all documents across pages", func() {
+		iter := testDb.QueryIter(context.Background(), query)
+		iter.PageSize = 3
+
+		count := 0
+		auto := CloudantAutomobile{}
+		for iter.Next(&auto) {
+			Ω(auto.Model).ShouldNot(BeEmpty())
+			count++
+		}
+
+		Ω(iter.Err()).NotTo(HaveOccurred())
+		Ω(count).Should(Equal(8))
+		Ω(iter.Bookmark()).ShouldNot(BeEmpty())
+	})
+
+	It("should use the default page size and ignore the query's limit", func() {
+		query.Limit = 2
+		iter := testDb.QueryIter(context.Background(), query)
+		Ω(iter.PageSize).Should(Equal(DefaultQueryPageSize))
+
+		count := 0
+		auto := CloudantAutomobile{}
+		for iter.Next(&auto) {
+			count++
+		}
+
+		Ω(iter.Err()).NotTo(HaveOccurred())
+		Ω(count).Should(Equal(8))
+	})
+
+	It("should honor the skip of the first page", func() {
+		query.Skip = 6
+		iter := testDb.QueryIter(context.Background(), query)
+		iter.PageSize = 3
+
+		count := 0
+		auto := CloudantAutomobile{}
+		for iter.Next(&auto) {
+			count++
+		}
+
+		Ω(iter.Err()).NotTo(HaveOccurred())
+		Ω(count).Should(Equal(2))
+	})
+
+	Describe("Error Handling", func() {
+		It("should stop when the context is cancelled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			iter := testDb.QueryIter(ctx, query)
+			auto := CloudantAutomobile{}
+			Ω(iter.Next(&auto)).Should(BeFalse())
+			Ω(iter.Err()).Should(Equal(context.Canceled))
+		})
+
+		It("should return an error if a document can't be decoded", func() {
+			iter := testDb.QueryIter(context.Background(), query)
+			invalid := 0
+			Ω(iter.Next(&invalid)).Should(BeFalse())
+			Ω(iter.Err()).To(HaveOccurred())
+		})
+
+		It("should return an error if the http request fails", func() {
+			db := errClientRequest.GetDatabase("non-existent-db-name")
+			iter := db.QueryIter(context.Background(), query)
+			auto := CloudantAutomobile{}
+			Ω(iter.Next(&auto)).Should(BeFalse())
+			Ω(iter.Err()).To(HaveOccurred())
+		})
+	})
+})
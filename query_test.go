@@ -27,7 +27,7 @@ var _ = Describe("Query", func() {
 		Context("With a non-indexed field", func() {
 			It("should return a 400 error", func() {
 				query.Selector["non-existent-field-name"] = 6
-				err := testDb.Query(query, &results)
+				_, err := testDb.Query(query, &results)
 				Ω(err).To(HaveOccurred())
 				Ω(err.(*CloudantError).StatusCode).Should(Equal(400))
 				Ω(err.(*CloudantError).Code).Should(Equal("no_usable_index"))
@@ -37,21 +37,21 @@ var _ = Describe("Query", func() {
 		Context("With one indexed field", func() {
 			It("should find zero documents", func() {
 				query.Selector["Model"] = "does-not-exist"
-				err := testDb.Query(query, &results)
+				_, err := testDb.Query(query, &results)
 				Ω(err).NotTo(HaveOccurred())
 				Ω(len(results)).Should(Equal(0))
 			})
 
 			It("should find one document", func() {
 				query.Selector["Model"] = "Diablo"
-				err := testDb.Query(query, &results)
+				_, err := testDb.Query(query, &results)
 				Ω(err).NotTo(HaveOccurred())
 				Ω(len(results)).Should(Equal(1))
 			})
 
 			It("should find two documents", func() {
 				query.Selector["Model"] = "Gallardo"
-				err := testDb.Query(query, &results)
+				_, err := testDb.Query(query, &results)
 				Ω(err).NotTo(HaveOccurred())
 				Ω(len(results)).Should(Equal(2))
 			})
@@ -59,7 +59,7 @@ var _ = Describe("Query", func() {
 			It("should specify explicit operators", func() {
 				operator["$eq"] = "Gallardo"
 				query.Selector["Model"] = operator
-				err := testDb.Query(query, &results)
+				_, err := testDb.Query(query, &results)
 				Ω(err).NotTo(HaveOccurred())
 				Ω(len(results)).Should(Equal(2))
 			})
@@ -68,7 +68,7 @@ var _ = Describe("Query", func() {
 				operator["$gt"] = "1111"
 				query.Selector["Model"] = operator
 				query.Limit = 6
-				err := testDb.Query(query, &results)
+				_, err := testDb.Query(query, &results)
 				Ω(err).NotTo(HaveOccurred())
 				Ω(len(results)).Should(Equal(6))
 			})
@@ -76,8 +76,9 @@ var _ = Describe("Query", func() {
 			It("should skip", func() {
 				operator["$gt"] = "1111"
 				query.Selector["Model"] = operator
+				query.Selector["Year"] = map[string]interface{}{"$gte": 2000} // only the query fixtures
 				query.Skip = 6
-				err := testDb.Query(query, &results)
+				_, err := testDb.Query(query, &results)
 				Ω(err).NotTo(HaveOccurred())
 				Ω(len(results)).Should(Equal(2))
 			})
@@ -87,14 +88,14 @@ var _ = Describe("Query", func() {
 			It("should find zero documents", func() {
 				query.Selector["Model"] = "458"
 				query.Selector["Trim"] = "sport"
-				err := testDb.Query(query, &results)
+				_, err := testDb.Query(query, &results)
 				Ω(err).NotTo(HaveOccurred())
 				Ω(len(results)).Should(Equal(0))
 			})
 			It("should find one document", func() {
 				query.Selector["Model"] = "550 Maranello"
 				query.Selector["Trim"] = "sport"
-				err := testDb.Query(query, &results)
+				_, err := testDb.Query(query, &results)
 				Ω(err).NotTo(HaveOccurred())
 				Ω(len(results)).Should(Equal(1))
 			})
@@ -102,7 +103,7 @@ var _ = Describe("Query", func() {
 			It("should find two documents", func() {
 				query.Selector["Model"] = "Gallardo"
 				query.Selector["Trim"] = "basic"
-				err := testDb.Query(query, &results)
+				_, err := testDb.Query(query, &results)
 				Ω(err).NotTo(HaveOccurred())
 				Ω(len(results)).Should(Equal(2))
 			})
@@ -112,7 +113,7 @@ var _ = Describe("Query", func() {
 			It("should find zero documents", func() {
 				query.Selector["Year"] = 2000
 				query.Selector["Make"] = "Packard"
-				err := testDb.Query(query, &results)
+				_, err := testDb.Query(query, &results)
 				Ω(err).NotTo(HaveOccurred())
 				Ω(len(results)).Should(Equal(0))
 			})
@@ -120,7 +121,7 @@ var _ = Describe("Query", func() {
 			It("should find one document", func() {
 				query.Selector["Year"] = 2000
 				query.Selector["Make"] = "Lamborghini"
-				err := testDb.Query(query, &results)
+				_, err := testDb.Query(query, &results)
 				Ω(err).NotTo(HaveOccurred())
 				Ω(len(results)).Should(Equal(1))
 			})
@@ -128,7 +129,7 @@ var _ = Describe("Query", func() {
 			It("should find two documents", func() {
 				query.Selector["Year"] = 2010
 				query.Selector["Make"] = "Ferrari"
-				err := testDb.Query(query, &results)
+				_, err := testDb.Query(query, &results)
 				Ω(err).NotTo(HaveOccurred())
 				Ω(len(results)).Should(Equal(2))
 			})
@@ -148,7 +149,7 @@ var _ = Describe("Query", func() {
 
 		It("should sort one field ascending", func() {
 			sortingQuery.Sort("Year", true)
-			err := testDb.Query(sortingQuery, &results)
+			_, err := testDb.Query(sortingQuery, &results)
 			Ω(err).NotTo(HaveOccurred())
 			Ω(len(results)).Should(Equal(8))
 			Ω(results[0].Year).Should(Equal(2000))
@@ -157,7 +158,7 @@ var _ = Describe("Query", func() {
 
 		It("should sort one field descending", func() {
 			sortingQuery.Sort("Year", false)
-			err := testDb.Query(sortingQuery, &results)
+			_, err := testDb.Query(sortingQuery, &results)
 			Ω(err).NotTo(HaveOccurred())
 			Ω(len(results)).Should(Equal(8))
 			Ω(results[0].Year).Should(Equal(2011))
@@ -165,10 +166,65 @@ var _ = Describe("Query", func() {
 		})
 	})
 
+	Describe("Options", func() {
+		It("should project fields", func() {
+			query.Selector["Model"] = "Diablo"
+			query.Fields = []string{"_id", "Model"}
+			_, err := testDb.Query(query, &results)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(len(results)).Should(Equal(1))
+			Ω(results[0].Model).Should(Equal("Diablo"))
+			Ω(results[0].Make).Should(BeEmpty())
+		})
+
+		It("should return a bookmark usable for the next page", func() {
+			// other tests add documents to the shared test database, so only match the query fixtures
+			operator["$gt"] = "1111"
+			query.Selector["Model"] = operator
+			query.Selector["Year"] = map[string]interface{}{"$gte": 2000}
+			query.Limit = 6
+			qr, err := testDb.Query(query, &results)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(len(results)).Should(Equal(6))
+			Ω(qr.Bookmark).ShouldNot(BeEmpty())
+
+			query.Bookmark = qr.Bookmark
+			_, err = testDb.Query(query, &results)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(len(results)).Should(Equal(2))
+		})
+
+		It("should return execution stats", func() {
+			query.Selector["Model"] = "Gallardo"
+			query.ExecutionStats = true
+			qr, err := testDb.Query(query, &results)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(qr.ExecutionStats).ShouldNot(BeNil())
+			Ω(qr.ExecutionStats.ResultsReturned).Should(Equal(2))
+		})
+
+		It("should use the specified index", func() {
+			indices, err := testDb.GetIndices()
+			Ω(err).NotTo(HaveOccurred())
+
+			for _, idx := range indices {
+				if len(idx.Definition.Fields) == 1 && idx.Definition.Fields[0]["Model"] != "" {
+					query.SetUseIndex(idx.DDocId, idx.Name)
+				}
+			}
+
+			query.Selector["Model"] = "Gallardo"
+			qr, err := testDb.Query(query, &results)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(len(results)).Should(Equal(2))
+			Ω(qr.Warning).Should(BeEmpty())
+		})
+	})
+
 	Describe("Error Handling", func() {
 		It("should return an error if selector fails json.Marshal", func() {
 			query.Selector = GenerateInvalidJson()
-			err := testDb.Query(query, &results)
+			_, err := testDb.Query(query, &results)
 			Ω(err).To(HaveOccurred())
 			Ω(err.Error()).Should(Equal("json: unsupported type: map[int]interface {}"))
 		})
@@ -176,7 +232,7 @@ var _ = Describe("Query", func() {
 		It("should return an error if the http request fails", func() {
 			db := errClientRequest.GetDatabase("non-existent-db-name")
 			query.Selector["Model"] = "Diablo"
-			err := db.Query(query, &results)
+			_, err := db.Query(query, &results)
 			Ω(err).To(HaveOccurred())
 		})
 	})
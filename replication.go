@@ -0,0 +1,199 @@
+package cloudant
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+const replicatorDbName = "_replicator"
+
+// Container for a replication document stored in the _replicator database
+// http://docs.cloudant.com/api/replication.html
+type Replication struct {
+	CloudantDocument
+	Source ReplicationEndpoint `json:"source"`
+	Target ReplicationEndpoint `json:"target"`
+	ReplicationOptions
+}
+
+// Options shared by replication documents and one-shot replications
+type ReplicationOptions struct {
+	Continuous      bool                   `json:"continuous,omitempty"`
+	CreateTarget    bool                   `json:"create_target,omitempty"`
+	Selector        map[string]interface{} `json:"selector,omitempty"`
+	DocIds          []string               `json:"doc_ids,omitempty"`
+	Filter          string                 `json:"filter,omitempty"`
+	QueryParams     map[string]interface{} `json:"query_params,omitempty"`
+	SinceSeq        string                 `json:"since_seq,omitempty"`
+	WorkerProcesses int                    `json:"worker_processes,omitempty"`
+}
+
+// The source or target database of a replication
+type ReplicationEndpoint struct {
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Auth    *ReplicationAuth  `json:"auth,omitempty"`
+}
+
+type ReplicationAuth struct {
+	Basic *ReplicationBasicAuth `json:"basic,omitempty"`
+}
+
+type ReplicationBasicAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// Result of a one-shot replication
+type ReplicationResult struct {
+	Ok                   bool                 `json:"ok"`
+	NoChanges            bool                 `json:"no_changes"`
+	SessionId            string               `json:"session_id"`
+	SourceLastSeq        interface{}          `json:"source_last_seq"`
+	ReplicationIdVersion int                  `json:"replication_id_version"`
+	History              []ReplicationHistory `json:"history"`
+}
+
+type ReplicationHistory struct {
+	SessionId        string      `json:"session_id"`
+	StartTime        string      `json:"start_time"`
+	EndTime          string      `json:"end_time"`
+	StartLastSeq     interface{} `json:"start_last_seq"`
+	EndLastSeq       interface{} `json:"end_last_seq"`
+	RecordedSeq      interface{} `json:"recorded_seq"`
+	MissingChecked   int         `json:"missing_checked"`
+	MissingFound     int         `json:"missing_found"`
+	DocsRead         int         `json:"docs_read"`
+	DocsWritten      int         `json:"docs_written"`
+	DocWriteFailures int         `json:"doc_write_failures"`
+}
+
+type replicateRequest struct {
+	Source ReplicationEndpoint `json:"source"`
+	Target ReplicationEndpoint `json:"target"`
+	Cancel bool                `json:"cancel,omitempty"`
+	ReplicationOptions
+}
+
+type replicationList struct {
+	Rows []struct {
+		Id  string      `json:"id"`
+		Doc Replication `json:"doc"`
+	} `json:"rows"`
+}
+
+// Create a replication endpoint.  Basic auth is only added if a username is provided.
+func NewReplicationEndpoint(url string, username string, password string) ReplicationEndpoint {
+	re := ReplicationEndpoint{URL: url}
+	if username != "" {
+		re.Auth = &ReplicationAuth{Basic: &ReplicationBasicAuth{Username: username, Password: password}}
+	}
+
+	return re
+}
+
+func NewReplication(source ReplicationEndpoint, target ReplicationEndpoint) *Replication {
+	return &Replication{Source: source, Target: target}
+}
+
+// Start a replication by writing a document to the _replicator database.
+// The document id is auto-generated if not specified.
+func (client *Client) CreateReplication(spec *Replication) (CloudantDocumentResponse, error) {
+	db := client.GetDatabase(replicatorDbName)
+	return db.CreateDocument(spec, false)
+}
+
+func (client *Client) GetReplication(id string) (*Replication, error) {
+	db := client.GetDatabase(replicatorDbName)
+	r := &Replication{}
+	err := db.GetDocument(id, r)
+
+	return r, err
+}
+
+// Stop a replication by deleting its document from the _replicator database.
+func (client *Client) CancelReplication(id string) (CloudantDocumentResponse, error) {
+	var r *Replication
+	var err error
+
+	if r, err = client.GetReplication(id); err != nil {
+		return CloudantDocumentResponse{}, err
+	}
+
+	db := client.GetDatabase(replicatorDbName)
+	return db.DeleteDocument(id, r.Revision())
+}
+
+// List all replication documents, excluding design documents.
+func (client *Client) ListReplications() ([]Replication, error) {
+	rl := replicationList{}
+
+	resp, err := client.doRequest("GET", "/"+replicatorDbName+"/_all_docs?include_docs=true", nil, nil)
+	err = client.handleResponse(resp, err, 200, &rl)
+
+	replications := make([]Replication, 0, len(rl.Rows))
+	for _, row := range rl.Rows {
+		if !strings.HasPrefix(row.Id, "_design/") {
+			replications = append(replications, row.Doc)
+		}
+	}
+
+	return replications, err
+}
+
+// Replicate from source to target and wait for the replication to complete.  The request isn't
+// subject to the transport's request timeouts, so the context is the only deadline.
+// If the request fails or the context is done, the replication is cancelled on the server via
+// CancelReplicate, since cloudant keeps running the replication after the connection is dropped.
+// The request's error (the context's error if it is done) is returned unless the replication could not be cancelled.
+// http://docs.cloudant.com/api/replication.html#the-replicate-endpoint
+func (client *Client) Replicate(ctx context.Context, source ReplicationEndpoint, target ReplicationEndpoint, opts ReplicationOptions) (*ReplicationResult, error) {
+	rr := &ReplicationResult{}
+
+	// continuous replications never complete, so they must be created via the _replicator database
+	opts.Continuous = false
+	j, err := json.Marshal(replicateRequest{Source: source, Target: target, ReplicationOptions: opts})
+	if err != nil {
+		return rr, err
+	}
+
+	resp, err := client.doLongRequestWithContext(ctx, "POST", "/_replicate", nil, bytes.NewReader(j))
+	if err != nil || ctx.Err() != nil {
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+
+		// a 404 means the replication already stopped or never started
+		cancelErr := client.CancelReplicate(source, target, opts)
+		if ce, ok := cancelErr.(*CloudantError); cancelErr != nil && !(ok && ce.StatusCode == 404) {
+			err = cancelErr
+		}
+
+		return rr, err
+	}
+
+	err = client.handleResponse(resp, err, 200, rr)
+
+	return rr, err
+}
+
+// Cancel a replication started via Replicate.  Source, target and options must match the ones used
+// to start the replication, since they identify it.
+func (client *Client) CancelReplicate(source ReplicationEndpoint, target ReplicationEndpoint, opts ReplicationOptions) error {
+	ok := okResponse{}
+
+	opts.Continuous = false
+	j, err := json.Marshal(replicateRequest{Source: source, Target: target, Cancel: true, ReplicationOptions: opts})
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.doRequest("POST", "/_replicate", nil, bytes.NewReader(j))
+	return client.handleResponse(resp, err, 200, &ok)
+}
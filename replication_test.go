@@ -0,0 +1,138 @@
+package cloudant_test
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/mreiferson/go-httpclient"
+	. "github.com/obieq/go-cloudant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var replicationTargetDbName string = "golang_suite_replication_target"
+
+// Create a replication between two databases of the test account
+func NewTestReplication(sourceDbName string, targetDbName string) *Replication {
+	source := NewReplicationEndpoint(os.Getenv("CLOUDANT_URL")+"/"+sourceDbName, os.Getenv("CLOUDANT_API_KEY"), os.Getenv("CLOUDANT_API_PASSWORD"))
+	target := NewReplicationEndpoint(os.Getenv("CLOUDANT_URL")+"/"+targetDbName, os.Getenv("CLOUDANT_API_KEY"), os.Getenv("CLOUDANT_API_PASSWORD"))
+
+	return NewReplication(source, target)
+}
+
+var _ = Describe("Replication", func() {
+	var replicationId string
+
+	BeforeEach(func() {
+		replicationId = "golang_replication_" + GenerateRandomUUID()
+	})
+
+	It("should create, get, list and cancel a replication", func() {
+		spec := NewTestReplication(testDbName, replicationTargetDbName)
+		spec.SetId(replicationId)
+		spec.Continuous = true
+		spec.CreateTarget = true
+		spec.Selector = Field("Make").Eq("Ferrari")
+		spec.WorkerProcesses = 2
+
+		cdr, err := testClient.CreateReplication(spec)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(cdr.Id).Should(Equal(replicationId))
+
+		r, err := testClient.GetReplication(replicationId)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(r.Id()).Should(Equal(replicationId))
+		Ω(r.Revision()).Should(Equal(cdr.Revision))
+		Ω(r.Continuous).Should(BeTrue())
+		Ω(r.Source.URL).Should(Equal(spec.Source.URL))
+		Ω(r.Selector).Should(HaveKey("Make"))
+
+		replications, err := testClient.ListReplications()
+		Ω(err).NotTo(HaveOccurred())
+		ids := []string{}
+		for _, r := range replications {
+			ids = append(ids, r.Id())
+		}
+		Ω(ids).Should(ContainElement(replicationId))
+
+		cdr, err = testClient.CancelReplication(replicationId)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(cdr.Id).Should(Equal(replicationId))
+	})
+
+	It("should create an endpoint without basic auth", func() {
+		endpoint := NewReplicationEndpoint("http://localhost:5984/db", "", "")
+		Ω(endpoint.Auth).Should(BeNil())
+	})
+
+	Describe("Replicating", func() {
+		It("should replicate and return the history", func() {
+			CreateDocumentAndAssert(&CloudantAutomobile{Year: 1962, Make: "Ferrari", Model: "250 GTO"}, "", false)
+
+			spec := NewTestReplication(testDbName, replicationTargetDbName)
+			rr, err := testClient.Replicate(context.Background(), spec.Source, spec.Target, ReplicationOptions{CreateTarget: true})
+			Ω(err).NotTo(HaveOccurred())
+			Ω(rr.Ok).Should(BeTrue())
+			Ω(rr.SessionId).ShouldNot(BeEmpty())
+			Ω(len(rr.History)).Should(BeNumerically(">", 0))
+			Ω(rr.History[0].DocWriteFailures).Should(Equal(0))
+		})
+
+		It("should not be subject to the transport's request timeouts", func() {
+			transport := &httpclient.Transport{ConnectTimeout: 1 * time.Second, RequestTimeout: 1 * time.Millisecond, ResponseHeaderTimeout: 1 * time.Millisecond}
+			c := NewClientWithTransport(os.Getenv("CLOUDANT_URL"), os.Getenv("CLOUDANT_API_KEY"), os.Getenv("CLOUDANT_API_PASSWORD"), transport)
+
+			spec := NewTestReplication(testDbName, replicationTargetDbName)
+			rr, err := c.Replicate(context.Background(), spec.Source, spec.Target, ReplicationOptions{CreateTarget: true})
+			Ω(err).NotTo(HaveOccurred())
+			Ω(rr.Ok).Should(BeTrue())
+		})
+
+		It("should stop when the context is cancelled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			spec := NewTestReplication(testDbName, replicationTargetDbName)
+			_, err := testClient.Replicate(ctx, spec.Source, spec.Target, ReplicationOptions{})
+			Ω(err).Should(Equal(context.Canceled))
+		})
+
+		It("should cancel the replication on the server when the context times out", func() {
+			spec := NewTestReplication(testDbName, replicationTargetDbName)
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			_, err := testClient.Replicate(ctx, spec.Source, spec.Target, ReplicationOptions{CreateTarget: true})
+			if err == nil {
+				Skip("replication completed before the context timed out")
+			}
+			Ω(err).Should(Equal(context.DeadlineExceeded))
+
+			// the replication is no longer running, so there is nothing left to cancel
+			err = testClient.CancelReplicate(spec.Source, spec.Target, ReplicationOptions{CreateTarget: true})
+			Ω(err).To(HaveOccurred())
+			Ω(err.(*CloudantError).StatusCode).Should(Equal(404))
+		})
+
+		It("should return an error if the selector fails json.Marshal", func() {
+			spec := NewTestReplication(testDbName, replicationTargetDbName)
+			_, err := testClient.Replicate(context.Background(), spec.Source, spec.Target, ReplicationOptions{Selector: GenerateInvalidJson()})
+			Ω(err).To(HaveOccurred())
+			Ω(err.Error()).Should(Equal("json: unsupported type: map[int]interface {}"))
+		})
+	})
+
+	Describe("Error Handling", func() {
+		It("should return a 404 error when cancelling a non-existent replication", func() {
+			_, err := testClient.CancelReplication(replicationId)
+			Ω(err).To(HaveOccurred())
+			Ω(err.(*CloudantError).StatusCode).Should(Equal(404))
+		})
+
+		It("should return an error if the http request fails", func() {
+			_, err := errClientRequest.ListReplications()
+			Ω(err).To(HaveOccurred())
+		})
+	})
+})
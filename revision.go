@@ -0,0 +1,213 @@
+package cloudant
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+// Options used when getting a document
+// http://docs.cloudant.com/api/documents.html#read
+type GetDocumentOptions struct {
+	Rev              string // a specific revision, defaults to the winning revision
+	Revs             bool   // include _revisions
+	RevsInfo         bool   // include _revs_info
+	Conflicts        bool   // include _conflicts
+	DeletedConflicts bool   // include _deleted_conflicts
+	Latest           bool   // return the latest leaf revision of the branch containing Rev
+	Meta             bool   // same as Conflicts, DeletedConflicts and RevsInfo
+	LocalSeq         bool   // include _local_seq
+}
+
+// Revision metadata returned along with a document
+type DocumentMeta struct {
+	Id               string         `json:"_id"`
+	Revision         string         `json:"_rev"`
+	Deleted          bool           `json:"_deleted"`
+	Revisions        *Revisions     `json:"_revisions"`
+	RevsInfo         []RevisionInfo `json:"_revs_info"`
+	Conflicts        []string       `json:"_conflicts"`
+	DeletedConflicts []string       `json:"_deleted_conflicts"`
+	LocalSeq         interface{}    `json:"_local_seq"`
+}
+
+// The revision history of a document, most recent first
+type Revisions struct {
+	Start int      `json:"start"`
+	Ids   []string `json:"ids"`
+}
+
+// The availability of a single revision
+type RevisionInfo struct {
+	Rev    string `json:"rev"`
+	Status string `json:"status"` // available, missing or deleted
+}
+
+// Result of comparing revisions with the database via _revs_diff
+type RevsDiff struct {
+	Missing           []string `json:"missing"`
+	PossibleAncestors []string `json:"possible_ancestors"`
+}
+
+type missingRevsResponse struct {
+	MissingRevs map[string][]string `json:"missing_revs"`
+}
+
+type openRevision struct {
+	Ok      *json.RawMessage `json:"ok"`
+	Missing string           `json:"missing"`
+}
+
+// Full revision ids, most recent first, e.g. ["3-c", "2-b", "1-a"]
+func (r *Revisions) RevIds() []string {
+	ids := make([]string, len(r.Ids))
+	for i, id := range r.Ids {
+		ids[i] = strconv.Itoa(r.Start-i) + "-" + id
+	}
+
+	return ids
+}
+
+func (opts GetDocumentOptions) values() url.Values {
+	v := url.Values{}
+	flags := map[string]bool{
+		"revs":              opts.Revs,
+		"revs_info":         opts.RevsInfo,
+		"conflicts":         opts.Conflicts,
+		"deleted_conflicts": opts.DeletedConflicts,
+		"latest":            opts.Latest,
+		"meta":              opts.Meta,
+		"local_seq":         opts.LocalSeq,
+	}
+
+	for k, isSet := range flags {
+		if isSet {
+			v.Set(k, "true")
+		}
+	}
+
+	if opts.Rev != "" {
+		v.Set("rev", opts.Rev)
+	}
+
+	return v
+}
+
+// Get a document along with its revision metadata.
+func (db *Database) GetDocumentWithOptions(id string, opts GetDocumentOptions, doc interface{}) (*DocumentMeta, error) {
+	meta := &DocumentMeta{}
+	uri := db.docPath(id)
+
+	if v := opts.values(); len(v) > 0 {
+		uri += "?" + v.Encode()
+	}
+
+	var raw json.RawMessage
+	resp, err := db.client.doRequest("GET", uri, nil, nil)
+	if err = db.client.handleResponse(resp, err, 200, &raw); err != nil {
+		return meta, err
+	}
+
+	if err = json.Unmarshal(raw, meta); err == nil {
+		err = json.Unmarshal(raw, doc)
+	}
+
+	return meta, err
+}
+
+// Get the given revisions of a document, or all leaf revisions if revs is empty.
+// Found revisions are decoded into docs, which must be a pointer to a slice.
+// The revisions that could not be found are returned.
+func (db *Database) GetOpenRevisions(id string, revs []string, isLatest bool, docs interface{}) ([]string, error) {
+	missing := []string{}
+	openRevs := []openRevision{}
+
+	v := url.Values{}
+	if len(revs) == 0 {
+		v.Set("open_revs", "all")
+	} else {
+		j, _ := json.Marshal(revs) // given that revs is a string array, json.Marshal shouldn't raise an error
+		v.Set("open_revs", string(j))
+	}
+
+	if isLatest {
+		v.Set("latest", "true")
+	}
+
+	resp, err := db.client.doRequest("GET", db.docPath(id)+"?"+v.Encode(), nil, nil)
+	if err = db.client.handleResponse(resp, err, 200, &openRevs); err != nil {
+		return missing, err
+	}
+
+	found := []*json.RawMessage{}
+	for _, rev := range openRevs {
+		if rev.Ok != nil {
+			found = append(found, rev.Ok)
+		} else if rev.Missing != "" {
+			missing = append(missing, rev.Missing)
+		}
+	}
+
+	j, err := json.Marshal(found)
+	if err == nil {
+		err = json.Unmarshal(j, docs)
+	}
+
+	return missing, err
+}
+
+// Get the revisions of all leaves of the document's revision tree, including deleted leaves.
+func (db *Database) GetLeafRevisions(id string) ([]DocumentMeta, error) {
+	leaves := []DocumentMeta{}
+	_, err := db.GetOpenRevisions(id, nil, false, &leaves)
+
+	return leaves, err
+}
+
+// Find which of the given revisions (document id => revisions) don't exist in the database,
+// along with already stored revisions that may be their ancestors.  Only documents with missing revisions are returned.
+// http://docs.couchdb.org/en/stable/api/database/misc.html#db-revs-diff
+func (db *Database) RevsDiff(revs map[string][]string) (map[string]RevsDiff, error) {
+	diff := make(map[string]RevsDiff)
+
+	j, err := json.Marshal(revs)
+	if err != nil {
+		return diff, err
+	}
+
+	resp, err := db.client.doRequest("POST", db.path()+"/_revs_diff", nil, bytes.NewReader(j))
+	err = db.client.handleResponse(resp, err, 200, &diff)
+	return diff, err
+}
+
+// Find which of the given revisions (document id => revisions) don't exist in the database.
+// http://docs.couchdb.org/en/stable/api/database/misc.html#db-missing-revs
+func (db *Database) MissingRevs(revs map[string][]string) (map[string][]string, error) {
+	mrr := missingRevsResponse{MissingRevs: make(map[string][]string)}
+
+	j, err := json.Marshal(revs)
+	if err != nil {
+		return mrr.MissingRevs, err
+	}
+
+	resp, err := db.client.doRequest("POST", db.path()+"/_missing_revs", nil, bytes.NewReader(j))
+	err = db.client.handleResponse(resp, err, 200, &mrr)
+	return mrr.MissingRevs, err
+}
+
+// Get the maximum number of revisions tracked per document.
+func (db *Database) GetRevsLimit() (int, error) {
+	limit := 0
+
+	resp, err := db.client.doRequest("GET", db.path()+"/_revs_limit", nil, nil)
+	err = db.client.handleResponse(resp, err, 200, &limit)
+	return limit, err
+}
+
+func (db *Database) SetRevsLimit(limit int) error {
+	ok := okResponse{}
+
+	resp, err := db.client.doRequest("PUT", db.path()+"/_revs_limit", nil, bytes.NewReader([]byte(strconv.Itoa(limit))))
+	return db.client.handleResponse(resp, err, 200, &ok)
+}
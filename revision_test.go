@@ -0,0 +1,138 @@
+package cloudant_test
+
+import (
+	. "github.com/obieq/go-cloudant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Revision", func() {
+	var (
+		auto   CloudantAutomobile
+		autoId string
+		revs   []string
+	)
+
+	BeforeEach(func() {
+		auto = CloudantAutomobile{Year: 1963, Make: "Aston Martin", Model: "DB5"}
+		autoId = GenerateRandomUUID()
+		auto.SetId(autoId)
+		revs = []string{}
+
+		cdr, err := testDb.CreateDocument(&auto, false)
+		Ω(err).NotTo(HaveOccurred())
+		revs = append(revs, cdr.Revision)
+
+		auto.DocRevision = cdr.Revision
+		auto.Year = 1964
+		cdr, err = testDb.UpdateDocument(&auto, false)
+		Ω(err).NotTo(HaveOccurred())
+		revs = append(revs, cdr.Revision)
+	})
+
+	It("should get a document with its revision history", func() {
+		doc := CloudantAutomobile{}
+		meta, err := testDb.GetDocumentWithOptions(autoId, GetDocumentOptions{Revs: true, RevsInfo: true, LocalSeq: true}, &doc)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(doc.Year).Should(Equal(1964))
+		Ω(meta.Id).Should(Equal(autoId))
+		Ω(meta.Revision).Should(Equal(revs[1]))
+		Ω(meta.Revisions).ShouldNot(BeNil())
+		Ω(meta.Revisions.Start).Should(Equal(2))
+		Ω(meta.Revisions.RevIds()).Should(Equal([]string{revs[1], revs[0]}))
+		Ω(len(meta.RevsInfo)).Should(Equal(2))
+		Ω(meta.RevsInfo[0].Rev).Should(Equal(revs[1]))
+		Ω(meta.RevsInfo[0].Status).Should(Equal("available"))
+	})
+
+	It("should get a specific revision", func() {
+		doc := CloudantAutomobile{}
+		meta, err := testDb.GetDocumentWithOptions(autoId, GetDocumentOptions{Rev: revs[0], Meta: true}, &doc)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(doc.Year).Should(Equal(1963))
+		Ω(meta.Revision).Should(Equal(revs[0]))
+	})
+
+	It("should get open revisions", func() {
+		docs := []CloudantAutomobile{}
+		missing, err := testDb.GetOpenRevisions(autoId, []string{revs[1], "9-doesnotexist"}, false, &docs)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(len(docs)).Should(Equal(1))
+		Ω(docs[0].Revision()).Should(Equal(revs[1]))
+		Ω(missing).Should(Equal([]string{"9-doesnotexist"}))
+	})
+
+	It("should get the latest revision of a branch", func() {
+		docs := []CloudantAutomobile{}
+		_, err := testDb.GetOpenRevisions(autoId, []string{revs[0]}, true, &docs)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(len(docs)).Should(Equal(1))
+		Ω(docs[0].Revision()).Should(Equal(revs[1]))
+	})
+
+	It("should get all leaf revisions", func() {
+		leaves, err := testDb.GetLeafRevisions(autoId)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(len(leaves)).Should(Equal(1))
+		Ω(leaves[0].Revision).Should(Equal(revs[1]))
+		Ω(leaves[0].Deleted).Should(BeFalse())
+	})
+
+	Describe("Syncing", func() {
+		It("should diff revisions", func() {
+			diff, err := testDb.RevsDiff(map[string][]string{autoId: []string{revs[1], "3-doesnotexist"}})
+			Ω(err).NotTo(HaveOccurred())
+			Ω(diff).Should(HaveKey(autoId))
+			Ω(diff[autoId].Missing).Should(Equal([]string{"3-doesnotexist"}))
+			Ω(diff[autoId].PossibleAncestors).Should(ContainElement(revs[1]))
+		})
+
+		It("should find missing revisions", func() {
+			missing, err := testDb.MissingRevs(map[string][]string{autoId: []string{revs[0], revs[1], "3-doesnotexist"}})
+			Ω(err).NotTo(HaveOccurred())
+			Ω(missing[autoId]).Should(Equal([]string{"3-doesnotexist"}))
+		})
+
+		It("should get and set the revs limit", func() {
+			original, err := testDb.GetRevsLimit()
+			Ω(err).NotTo(HaveOccurred())
+			Ω(original).Should(BeNumerically(">", 0))
+
+			err = testDb.SetRevsLimit(original + 1)
+			Ω(err).NotTo(HaveOccurred())
+
+			limit, err := testDb.GetRevsLimit()
+			Ω(err).NotTo(HaveOccurred())
+			Ω(limit).Should(Equal(original + 1))
+
+			err = testDb.SetRevsLimit(original)
+			Ω(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("Error Handling", func() {
+		It("should return a 404 error for a non-existent document", func() {
+			_, err := testDb.GetDocumentWithOptions("does_not_exist", GetDocumentOptions{}, &CloudantAutomobile{})
+			Ω(err).To(HaveOccurred())
+			Ω(err.(*CloudantError).StatusCode).Should(Equal(404))
+
+			_, err = testDb.GetLeafRevisions("does_not_exist")
+			Ω(err).To(HaveOccurred())
+		})
+
+		It("should return an error if the http request fails", func() {
+			db := errClientRequest.GetDatabase("non-existent-db-name")
+			_, err := db.RevsDiff(map[string][]string{"id": []string{"1-abc"}})
+			Ω(err).To(HaveOccurred())
+
+			_, err = db.MissingRevs(map[string][]string{"id": []string{"1-abc"}})
+			Ω(err).To(HaveOccurred())
+
+			_, err = db.GetRevsLimit()
+			Ω(err).To(HaveOccurred())
+
+			err = db.SetRevsLimit(1000)
+			Ω(err).To(HaveOccurred())
+		})
+	})
+})
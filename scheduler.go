@@ -0,0 +1,174 @@
+package cloudant
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Replication states reported by the scheduler
+// http://docs.couchdb.org/en/stable/replication/replicator.html#replication-states
+const (
+	ReplicationStateInitializing = "initializing"
+	ReplicationStateRunning      = "running"
+	ReplicationStatePending      = "pending"
+	ReplicationStateCrashing     = "crashing"
+	ReplicationStateFailed       = "failed"
+	ReplicationStateCompleted    = "completed"
+	ReplicationStateError        = "error"
+)
+
+// How often WaitForReplication checks the state of a replication
+var ReplicationPollInterval time.Duration = 2 * time.Second
+
+// How long WaitForReplication waits for the scheduler to pick up a new replication
+// before a 404 is returned as an error
+var ReplicationNotFoundGracePeriod time.Duration = 30 * time.Second
+
+// A replication job currently known to the scheduler
+// http://docs.couchdb.org/en/stable/api/server/common.html#scheduler-jobs
+type SchedulerJob struct {
+	Database  string                `json:"database"`
+	Id        string                `json:"id"`
+	Pid       string                `json:"pid"`
+	Source    string                `json:"source"`
+	Target    string                `json:"target"`
+	User      string                `json:"user"`
+	DocId     string                `json:"doc_id"`
+	Node      string                `json:"node"`
+	StartTime string                `json:"start_time"`
+	History   []SchedulerJobHistory `json:"history"`
+	Info      SchedulerInfo         `json:"info"`
+}
+
+type SchedulerJobHistory struct {
+	Timestamp string `json:"timestamp"`
+	Type      string `json:"type"`
+	Reason    string `json:"reason"`
+}
+
+// The state of a replication document as tracked by the scheduler
+// http://docs.couchdb.org/en/stable/api/server/common.html#scheduler-docs
+type SchedulerDoc struct {
+	Database    string        `json:"database"`
+	DocId       string        `json:"doc_id"`
+	Id          string        `json:"id"`
+	Node        string        `json:"node"`
+	Source      string        `json:"source"`
+	Target      string        `json:"target"`
+	State       string        `json:"state"`
+	Info        SchedulerInfo `json:"info"`
+	ErrorCount  int           `json:"error_count"`
+	LastUpdated string        `json:"last_updated"`
+	StartTime   string        `json:"start_time"`
+	SourceProxy string        `json:"source_proxy"`
+	TargetProxy string        `json:"target_proxy"`
+}
+
+// Replication statistics, or the error of a crashing or failed replication
+type SchedulerInfo struct {
+	RevisionsChecked      int         `json:"revisions_checked"`
+	MissingRevisionsFound int         `json:"missing_revisions_found"`
+	DocsRead              int         `json:"docs_read"`
+	DocsWritten           int         `json:"docs_written"`
+	ChangesPending        int         `json:"changes_pending"`
+	DocWriteFailures      int         `json:"doc_write_failures"`
+	CheckpointedSourceSeq interface{} `json:"checkpointed_source_seq"`
+	SourceSeq             interface{} `json:"source_seq"`
+	ThroughSeq            interface{} `json:"through_seq"`
+	Error                 string      `json:"error"`
+}
+
+type schedulerJobList struct {
+	TotalRows int            `json:"total_rows"`
+	Offset    int            `json:"offset"`
+	Jobs      []SchedulerJob `json:"jobs"`
+}
+
+type schedulerDocList struct {
+	TotalRows int            `json:"total_rows"`
+	Offset    int            `json:"offset"`
+	Docs      []SchedulerDoc `json:"docs"`
+}
+
+// An implementation of 'error' returned when a replication fails.
+type ReplicationError struct {
+	DocId  string
+	State  string
+	Reason string
+}
+
+func (e *ReplicationError) Error() string {
+	return fmt.Sprintf("replication %s %s: %s", e.DocId, e.State, e.Reason)
+}
+
+func (client *Client) GetSchedulerJobs() ([]SchedulerJob, error) {
+	sjl := schedulerJobList{}
+
+	resp, err := client.doRequest("GET", "/_scheduler/jobs", nil, nil)
+	err = client.handleResponse(resp, err, 200, &sjl)
+
+	return sjl.Jobs, err
+}
+
+func (client *Client) GetSchedulerDocs() ([]SchedulerDoc, error) {
+	sdl := schedulerDocList{}
+
+	resp, err := client.doRequest("GET", "/_scheduler/docs", nil, nil)
+	err = client.handleResponse(resp, err, 200, &sdl)
+
+	return sdl.Docs, err
+}
+
+// Get the state of a single replication document.  The replicator database is usually "_replicator".
+func (client *Client) GetSchedulerDoc(replicatorDb string, docId string) (*SchedulerDoc, error) {
+	return client.getSchedulerDocWithContext(context.Background(), replicatorDb, docId)
+}
+
+func (client *Client) getSchedulerDocWithContext(ctx context.Context, replicatorDb string, docId string) (*SchedulerDoc, error) {
+	sd := &SchedulerDoc{}
+
+	resp, err := client.doRequestWithContext(ctx, "GET", "/_scheduler/docs/"+escapePathSegment(replicatorDb)+"/"+escapePathSegment(docId), nil, nil)
+	err = client.handleResponse(resp, err, 200, sd)
+
+	return sd, err
+}
+
+// Poll the scheduler until the replication stored in the _replicator database under
+// the given id completes or fails.  Continuous replications never complete, so the
+// context should carry a deadline.  A replication the scheduler doesn't know about
+// results in a 404 error once ReplicationNotFoundGracePeriod has passed.
+func (client *Client) WaitForReplication(ctx context.Context, id string) (*SchedulerDoc, error) {
+	start := time.Now()
+	isSeen := false
+
+	for {
+		sd, err := client.getSchedulerDocWithContext(ctx, replicatorDbName, id)
+
+		// the scheduler may not have picked up a newly created replication yet
+		if ce, ok := err.(*CloudantError); ok && ce.StatusCode == 404 && !isSeen && time.Since(start) < ReplicationNotFoundGracePeriod {
+			err = nil
+		} else if err == nil {
+			isSeen = true
+		}
+
+		if err != nil {
+			return sd, err
+		}
+
+		switch sd.State {
+		case ReplicationStateCompleted:
+			return sd, nil
+		case ReplicationStateFailed, ReplicationStateError:
+			return sd, &ReplicationError{DocId: id, State: sd.State, Reason: sd.Info.Error}
+		}
+
+		timer := time.NewTimer(ReplicationPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return sd, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package cloudant_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/obieq/go-cloudant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Scheduler", func() {
+	var replicationId string
+
+	BeforeEach(func() {
+		replicationId = "golang_scheduler_" + GenerateRandomUUID()
+	})
+
+	It("should get scheduler jobs", func() {
+		jobs, err := testClient.GetSchedulerJobs()
+		Ω(err).NotTo(HaveOccurred())
+		Ω(jobs).ShouldNot(BeNil())
+	})
+
+	It("should wait for a replication to complete", func() {
+		CreateDocumentAndAssert(&CloudantAutomobile{Year: 1955, Make: "Mercedes-Benz", Model: "300 SL"}, "", false)
+
+		spec := NewTestReplication(testDbName, replicationTargetDbName)
+		spec.SetId(replicationId)
+		spec.CreateTarget = true
+		_, err := testClient.CreateReplication(spec)
+		Ω(err).NotTo(HaveOccurred())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		sd, err := testClient.WaitForReplication(ctx, replicationId)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(sd.State).Should(Equal(ReplicationStateCompleted))
+		Ω(sd.DocId).Should(Equal(replicationId))
+		Ω(sd.Info.DocsRead).Should(BeNumerically(">", 0))
+
+		sd, err = testClient.GetSchedulerDoc("_replicator", replicationId)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(sd.State).Should(Equal(ReplicationStateCompleted))
+
+		docs, err := testClient.GetSchedulerDocs()
+		Ω(err).NotTo(HaveOccurred())
+		ids := []string{}
+		for _, d := range docs {
+			ids = append(ids, d.DocId)
+		}
+		Ω(ids).Should(ContainElement(replicationId))
+	})
+
+	Describe("Error Handling", func() {
+		It("should stop waiting when the context is cancelled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := testClient.WaitForReplication(ctx, replicationId)
+			Ω(err).To(HaveOccurred())
+		})
+
+		It("should return a 404 error for an unknown replication after the grace period", func() {
+			gracePeriod := ReplicationNotFoundGracePeriod
+			ReplicationNotFoundGracePeriod = 0
+			defer func() { ReplicationNotFoundGracePeriod = gracePeriod }()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+			defer cancel()
+
+			_, err := testClient.WaitForReplication(ctx, "does_not_exist")
+			Ω(err).To(HaveOccurred())
+			Ω(err.(*CloudantError).StatusCode).Should(Equal(404))
+		})
+
+		It("should describe a failed replication", func() {
+			re := &ReplicationError{DocId: replicationId, State: ReplicationStateFailed, Reason: "db_not_found"}
+			Ω(re.Error()).Should(Equal("replication " + replicationId + " failed: db_not_found"))
+		})
+
+		It("should return an error if the http request fails", func() {
+			_, err := errClientRequest.GetSchedulerJobs()
+			Ω(err).To(HaveOccurred())
+
+			_, err = errClientRequest.GetSchedulerDocs()
+			Ω(err).To(HaveOccurred())
+		})
+	})
+})
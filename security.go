@@ -0,0 +1,117 @@
+package cloudant
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Cloudant permissions that can be granted to an API key or user
+// http://docs.cloudant.com/api/authorization.html#roles
+const (
+	PermissionReader     = "_reader"
+	PermissionWriter     = "_writer"
+	PermissionAdmin      = "_admin"
+	PermissionReplicator = "_replicator"
+)
+
+// Container for a database security document
+// http://docs.cloudant.com/api/authorization.html
+type Security struct {
+	Admins          SecurityGroup       `json:"admins"`
+	Members         SecurityGroup       `json:"members"`
+	Cloudant        map[string][]string `json:"cloudant,omitempty"` // API key or user name => permissions
+	CouchdbAuthOnly bool                `json:"couchdb_auth_only,omitempty"`
+
+	// Other fields of the security document, kept so they survive a get-modify-set round trip
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Security without its json methods
+type securityFields Security
+
+// CouchDB style user names and roles
+type SecurityGroup struct {
+	Names []string `json:"names,omitempty"`
+	Roles []string `json:"roles,omitempty"`
+}
+
+type okResponse struct {
+	Ok bool `json:"ok"`
+}
+
+// Grant the given Cloudant permissions to an API key or user name, replacing any existing permissions.
+func (sec *Security) Grant(name string, permissions ...string) {
+	if sec.Cloudant == nil {
+		sec.Cloudant = make(map[string][]string)
+	}
+
+	sec.Cloudant[name] = permissions
+}
+
+// Remove all Cloudant permissions of an API key or user name.
+func (sec *Security) Revoke(name string) {
+	delete(sec.Cloudant, name)
+}
+
+func (sec *Security) UnmarshalJSON(data []byte) error {
+	fields := securityFields{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	extra := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &extra); err != nil {
+		return err
+	}
+
+	for _, k := range []string{"admins", "members", "cloudant", "couchdb_auth_only"} {
+		delete(extra, k)
+	}
+
+	if len(extra) > 0 {
+		fields.Extra = extra
+	}
+	*sec = Security(fields)
+
+	return nil
+}
+
+func (sec *Security) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(securityFields(*sec))
+	if err != nil || len(sec.Extra) == 0 {
+		return j, err
+	}
+
+	doc := make(map[string]json.RawMessage)
+	for k, v := range sec.Extra {
+		doc[k] = v
+	}
+
+	// the modelled fields take precedence over extra fields of the same name
+	if err = json.Unmarshal(j, &doc); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(doc)
+}
+
+func (db *Database) GetSecurity() (*Security, error) {
+	sec := &Security{}
+
+	resp, err := db.client.doRequest("GET", db.path()+"/_security", nil, nil)
+	err = db.client.handleResponse(resp, err, 200, sec)
+
+	return sec, err
+}
+
+func (db *Database) SetSecurity(sec *Security) error {
+	ok := okResponse{}
+
+	j, err := json.Marshal(sec)
+	if err != nil {
+		return err
+	}
+
+	resp, err := db.client.doRequest("PUT", db.path()+"/_security", nil, bytes.NewReader(j))
+	return db.client.handleResponse(resp, err, 200, &ok)
+}
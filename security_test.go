@@ -0,0 +1,88 @@
+package cloudant_test
+
+import (
+	"encoding/json"
+
+	. "github.com/obieq/go-cloudant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security", func() {
+	var securityDb *Database
+	var securityDbName string = "golang_suite_security"
+
+	BeforeEach(func() {
+		testClient.DeleteDatabase(securityDbName)
+		_, err := testClient.CreateDatabase(securityDbName)
+		Ω(err).NotTo(HaveOccurred())
+		securityDb, err = NewDatabase(securityDbName, testClient)
+		Ω(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		testClient.DeleteDatabase(securityDbName)
+	})
+
+	It("should set and get cloudant permissions", func() {
+		sec := &Security{}
+		sec.Grant("nobody", PermissionReader, PermissionWriter)
+		sec.Grant("revoked", PermissionAdmin)
+		sec.Revoke("revoked")
+
+		err := securityDb.SetSecurity(sec)
+		Ω(err).NotTo(HaveOccurred())
+
+		sec, err = securityDb.GetSecurity()
+		Ω(err).NotTo(HaveOccurred())
+		Ω(sec.Cloudant).Should(HaveKey("nobody"))
+		Ω(sec.Cloudant).ShouldNot(HaveKey("revoked"))
+		Ω(sec.Cloudant["nobody"]).Should(ConsistOf(PermissionReader, PermissionWriter))
+	})
+
+	It("should set and get couchdb admins and members", func() {
+		sec := &Security{
+			Admins:  SecurityGroup{Names: []string{"admin"}, Roles: []string{"ops"}},
+			Members: SecurityGroup{Roles: []string{"analysts"}},
+		}
+
+		err := securityDb.SetSecurity(sec)
+		Ω(err).NotTo(HaveOccurred())
+
+		sec, err = securityDb.GetSecurity()
+		Ω(err).NotTo(HaveOccurred())
+		Ω(sec.Admins.Names).Should(Equal([]string{"admin"}))
+		Ω(sec.Admins.Roles).Should(Equal([]string{"ops"}))
+		Ω(sec.Members.Roles).Should(Equal([]string{"analysts"}))
+	})
+
+	It("should preserve unknown fields when granting and revoking api keys", func() {
+		sec := &Security{Extra: map[string]json.RawMessage{"custom_setting": json.RawMessage(`{"enabled":true}`)}}
+		sec.Grant("revoked", PermissionReader)
+		err := securityDb.SetSecurity(sec)
+		Ω(err).NotTo(HaveOccurred())
+
+		err = securityDb.GrantAPIKey("nobody", PermissionReader)
+		Ω(err).NotTo(HaveOccurred())
+		err = securityDb.RevokeAPIKey("revoked")
+		Ω(err).NotTo(HaveOccurred())
+
+		sec, err = securityDb.GetSecurity()
+		Ω(err).NotTo(HaveOccurred())
+		Ω(sec.Cloudant).Should(HaveKey("nobody"))
+		Ω(sec.Cloudant).ShouldNot(HaveKey("revoked"))
+		Ω(sec.Extra).Should(HaveKey("custom_setting"))
+		Ω(string(sec.Extra["custom_setting"])).Should(MatchJSON(`{"enabled":true}`))
+	})
+
+	Describe("Error Handling", func() {
+		It("should return an error if the http request fails", func() {
+			db := errClientRequest.GetDatabase("non-existent-db-name")
+			_, err := db.GetSecurity()
+			Ω(err).To(HaveOccurred())
+
+			err = db.SetSecurity(&Security{})
+			Ω(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,89 @@
+package cloudant
+
+// A cloudant query selector.  Selectors can be assigned directly to Query.Selector.
+// http://docs.cloudant.com/api/cloudant-query.html?highlight=query#selector-syntax
+// e.g. query.Selector = Field("Year").Gte(2005).And(Field("Make").In("Ferrari", "Lamborghini"))
+type Selector map[string]interface{}
+
+// Builds conditions on a single field.
+type FieldSelector struct {
+	name string
+}
+
+// Start a condition on the named field.
+func Field(name string) *FieldSelector {
+	return &FieldSelector{name: name}
+}
+
+// Start a condition without a field name, for use with operators that match
+// array elements or map values directly, e.g. Field("Tags").ElemMatch(Value().Eq("sport"))
+func Value() *FieldSelector {
+	return &FieldSelector{}
+}
+
+func (f *FieldSelector) op(operator string, arg interface{}) Selector {
+	cond := Selector{operator: arg}
+	if f.name == "" {
+		return cond
+	}
+
+	return Selector{f.name: cond}
+}
+
+func (f *FieldSelector) Eq(v interface{}) Selector  { return f.op("$eq", v) }
+func (f *FieldSelector) Ne(v interface{}) Selector  { return f.op("$ne", v) }
+func (f *FieldSelector) Gt(v interface{}) Selector  { return f.op("$gt", v) }
+func (f *FieldSelector) Gte(v interface{}) Selector { return f.op("$gte", v) }
+func (f *FieldSelector) Lt(v interface{}) Selector  { return f.op("$lt", v) }
+func (f *FieldSelector) Lte(v interface{}) Selector { return f.op("$lte", v) }
+
+func (f *FieldSelector) Exists(exists bool) Selector { return f.op("$exists", exists) }
+
+// Valid types are null, boolean, number, string, array and object.
+func (f *FieldSelector) Type(t string) Selector { return f.op("$type", t) }
+
+func (f *FieldSelector) In(values ...interface{}) Selector  { return f.op("$in", values) }
+func (f *FieldSelector) Nin(values ...interface{}) Selector { return f.op("$nin", values) }
+func (f *FieldSelector) All(values ...interface{}) Selector { return f.op("$all", values) }
+
+func (f *FieldSelector) Size(size int) Selector { return f.op("$size", size) }
+
+func (f *FieldSelector) Mod(divisor, remainder int) Selector {
+	return f.op("$mod", []int{divisor, remainder})
+}
+
+func (f *FieldSelector) Regex(pattern string) Selector { return f.op("$regex", pattern) }
+
+func (f *FieldSelector) ElemMatch(s Selector) Selector   { return f.op("$elemMatch", s) }
+func (f *FieldSelector) AllMatch(s Selector) Selector    { return f.op("$allMatch", s) }
+func (f *FieldSelector) KeyMapMatch(s Selector) Selector { return f.op("$keyMapMatch", s) }
+
+// Negate a condition on the field, e.g. Field("Year").Not(Value().Gt(2000))
+func (f *FieldSelector) Not(s Selector) Selector { return f.op("$not", s) }
+
+// Match documents matching all of the selectors.
+func MatchAll(selectors ...Selector) Selector { return Selector{"$and": selectors} }
+
+// Match documents matching any of the selectors.
+func MatchAny(selectors ...Selector) Selector { return Selector{"$or": selectors} }
+
+// Match documents matching none of the selectors.
+func MatchNone(selectors ...Selector) Selector { return Selector{"$nor": selectors} }
+
+// Match documents not matching the selector.
+func MatchNot(s Selector) Selector { return Selector{"$not": s} }
+
+// Full text search, which requires a text index.
+func Text(search string) Selector { return Selector{"$text": search} }
+
+func (s Selector) And(selectors ...Selector) Selector {
+	return MatchAll(append([]Selector{s}, selectors...)...)
+}
+
+func (s Selector) Or(selectors ...Selector) Selector {
+	return MatchAny(append([]Selector{s}, selectors...)...)
+}
+
+func (s Selector) Nor(selectors ...Selector) Selector {
+	return MatchNone(append([]Selector{s}, selectors...)...)
+}
@@ -0,0 +1,82 @@
+package cloudant_test
+
+import (
+	"encoding/json"
+
+	. "github.com/obieq/go-cloudant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func MarshalSelectorAndAssert(s Selector, expected string) {
+	j, err := json.Marshal(s)
+	Ω(err).NotTo(HaveOccurred())
+	Ω(string(j)).Should(MatchJSON(expected))
+}
+
+var _ = Describe("Selector", func() {
+	Describe("Building", func() {
+		It("should build comparison operators", func() {
+			MarshalSelectorAndAssert(Field("Year").Eq(2005), `{"Year": {"$eq": 2005}}`)
+			MarshalSelectorAndAssert(Field("Year").Ne(2005), `{"Year": {"$ne": 2005}}`)
+			MarshalSelectorAndAssert(Field("Year").Gt(2005), `{"Year": {"$gt": 2005}}`)
+			MarshalSelectorAndAssert(Field("Year").Gte(2005), `{"Year": {"$gte": 2005}}`)
+			MarshalSelectorAndAssert(Field("Year").Lt(2005), `{"Year": {"$lt": 2005}}`)
+			MarshalSelectorAndAssert(Field("Year").Lte(2005), `{"Year": {"$lte": 2005}}`)
+		})
+
+		It("should build object operators", func() {
+			MarshalSelectorAndAssert(Field("Trim").Exists(true), `{"Trim": {"$exists": true}}`)
+			MarshalSelectorAndAssert(Field("Trim").Type("string"), `{"Trim": {"$type": "string"}}`)
+		})
+
+		It("should build array operators", func() {
+			MarshalSelectorAndAssert(Field("Make").In("Ferrari", "Lamborghini"), `{"Make": {"$in": ["Ferrari", "Lamborghini"]}}`)
+			MarshalSelectorAndAssert(Field("Make").Nin("Packard"), `{"Make": {"$nin": ["Packard"]}}`)
+			MarshalSelectorAndAssert(Field("Tags").All("fast", "red"), `{"Tags": {"$all": ["fast", "red"]}}`)
+			MarshalSelectorAndAssert(Field("Tags").Size(2), `{"Tags": {"$size": 2}}`)
+			MarshalSelectorAndAssert(Field("Tags").ElemMatch(Value().Eq("fast")), `{"Tags": {"$elemMatch": {"$eq": "fast"}}}`)
+			MarshalSelectorAndAssert(Field("Owners").AllMatch(Field("Age").Gt(18)), `{"Owners": {"$allMatch": {"Age": {"$gt": 18}}}}`)
+			MarshalSelectorAndAssert(Field("Parts").KeyMapMatch(Value().Eq("engine")), `{"Parts": {"$keyMapMatch": {"$eq": "engine"}}}`)
+		})
+
+		It("should build miscellaneous operators", func() {
+			MarshalSelectorAndAssert(Field("Year").Mod(4, 0), `{"Year": {"$mod": [4, 0]}}`)
+			MarshalSelectorAndAssert(Field("Model").Regex("^G"), `{"Model": {"$regex": "^G"}}`)
+			MarshalSelectorAndAssert(Field("Year").Not(Value().Gt(2000)), `{"Year": {"$not": {"$gt": 2000}}}`)
+		})
+
+		It("should build combination operators", func() {
+			MarshalSelectorAndAssert(
+				Field("Year").Gte(2005).And(Field("Make").In("Ferrari", "Lamborghini")),
+				`{"$and": [{"Year": {"$gte": 2005}}, {"Make": {"$in": ["Ferrari", "Lamborghini"]}}]}`)
+			MarshalSelectorAndAssert(
+				Field("Year").Eq(2000).Or(Field("Year").Eq(2010)),
+				`{"$or": [{"Year": {"$eq": 2000}}, {"Year": {"$eq": 2010}}]}`)
+			MarshalSelectorAndAssert(
+				Field("Year").Eq(2000).Nor(Field("Year").Eq(2010)),
+				`{"$nor": [{"Year": {"$eq": 2000}}, {"Year": {"$eq": 2010}}]}`)
+			MarshalSelectorAndAssert(MatchNot(Field("Year").Eq(2000)), `{"$not": {"Year": {"$eq": 2000}}}`)
+			MarshalSelectorAndAssert(
+				MatchAny(Field("Year").Eq(2000), Field("Year").Eq(2005), Field("Year").Eq(2010)),
+				`{"$or": [{"Year": {"$eq": 2000}}, {"Year": {"$eq": 2005}}, {"Year": {"$eq": 2010}}]}`)
+			MarshalSelectorAndAssert(Text("Gallardo"), `{"$text": "Gallardo"}`)
+		})
+	})
+
+	Describe("Querying", func() {
+		BeforeEach(func() {
+			createTestQueryDataWithIndices()
+		})
+
+		It("should be usable as a query selector", func() {
+			results := []CloudantAutomobile{}
+			query := NewQuery()
+			query.Selector = Field("Year").Gte(2005).And(Field("Make").In("Ferrari", "Lamborghini"))
+
+			_, err := testDb.Query(query, &results)
+			Ω(err).NotTo(HaveOccurred())
+			Ω(len(results)).Should(Equal(6))
+		})
+	})
+})
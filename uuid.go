@@ -0,0 +1,75 @@
+package cloudant
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+)
+
+// Number of UUIDs fetched at a time by a UUIDPool when no batch size is specified
+const DefaultUUIDBatchSize = 100
+
+type uuidList struct {
+	UUIDs []string `json:"uuids"`
+}
+
+// Get UUIDs generated by the server.
+// http://docs.couchdb.org/en/stable/api/server/common.html#uuids
+func (client *Client) UUIDs(count int) ([]string, error) {
+	ul := uuidList{}
+
+	resp, err := client.doRequest("GET", "/_uuids?count="+strconv.Itoa(count), nil, nil)
+	err = client.handleResponse(resp, err, 200, &ul)
+
+	return ul.UUIDs, err
+}
+
+// A pool of server generated UUIDs, fetched in batches, that lets document ids be
+// assigned up front so documents can be created with idempotent PUT requests.
+// A UUIDPool is safe for concurrent use.
+type UUIDPool struct {
+	client    *Client
+	batchSize int
+	uuids     []string
+	mu        sync.Mutex
+}
+
+func NewUUIDPool(client *Client, batchSize int) *UUIDPool {
+	if batchSize <= 0 {
+		batchSize = DefaultUUIDBatchSize
+	}
+
+	return &UUIDPool{client: client, batchSize: batchSize}
+}
+
+// Get an unused UUID, fetching a new batch from the server when the pool is empty.
+func (pool *UUIDPool) Next() (string, error) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if len(pool.uuids) == 0 {
+		uuids, err := pool.client.UUIDs(pool.batchSize)
+		if err != nil {
+			return "", err
+		}
+		pool.uuids = uuids
+	}
+
+	// guard against an empty response
+	if len(pool.uuids) == 0 {
+		return "", errors.New("server returned no uuids")
+	}
+
+	uuid := pool.uuids[0]
+	pool.uuids = pool.uuids[1:]
+
+	return uuid, nil
+}
+
+// Number of UUIDs left before the next batch is fetched.
+func (pool *UUIDPool) Len() int {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	return len(pool.uuids)
+}
@@ -0,0 +1,43 @@
+package cloudant_test
+
+import (
+	. "github.com/obieq/go-cloudant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UUID", func() {
+	It("should get uuids", func() {
+		uuids, err := testClient.UUIDs(3)
+		Ω(err).NotTo(HaveOccurred())
+		Ω(len(uuids)).Should(Equal(3))
+		Ω(uuids[0]).ShouldNot(Equal(uuids[1]))
+	})
+
+	It("should hand out uuids from a pool", func() {
+		pool := NewUUIDPool(testClient, 2)
+		Ω(pool.Len()).Should(Equal(0))
+
+		seen := make(map[string]bool)
+		for i := 0; i < 5; i++ {
+			uuid, err := pool.Next()
+			Ω(err).NotTo(HaveOccurred())
+			Ω(seen).ShouldNot(HaveKey(uuid))
+			seen[uuid] = true
+		}
+
+		// the third batch has one uuid left
+		Ω(pool.Len()).Should(Equal(1))
+	})
+
+	Describe("Error Handling", func() {
+		It("should return an error if the http request fails", func() {
+			_, err := errClientRequest.UUIDs(1)
+			Ω(err).To(HaveOccurred())
+
+			pool := NewUUIDPool(errClientRequest, 0)
+			_, err = pool.Next()
+			Ω(err).To(HaveOccurred())
+		})
+	})
+})